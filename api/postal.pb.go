@@ -14,6 +14,9 @@
 		Network
 		Pool
 		Binding
+		Event
+		Role
+		AuditEntry
 		NetworkRangeRequest
 		NetworkRangeResponse
 		NetworkAddRequest
@@ -36,14 +39,31 @@
 		BulkAllocateAddressResponse
 		BindAddressRequest
 		BindAddressResponse
+		BindAddressesRequest
+		BindAddressesResponse
 		ReleaseAddressRequest
 		ReleaseAddressResponse
+		RenewBindingRequest
+		RenewBindingResponse
+		AddressHistoryRequest
+		AddressHistoryResponse
+		WatchRequest
+		WatchResponse
+		RoleRangeRequest
+		RoleRangeResponse
+		RoleSetRequest
+		RoleSetResponse
+		RoleRemoveRequest
+		RoleRemoveResponse
+		AuditRangeRequest
+		AuditRangeResponse
 */
 package api
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import _ "github.com/gengo/grpc-gateway/third_party/googleapis/google/api"
 
 import (
 	context "golang.org/x/net/context"
@@ -87,6 +107,72 @@ func (x Pool_Type) String() string {
 }
 func (Pool_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorPostal, []int{3, 0} }
 
+// Strategy of choosing which released binding BindAny reuses
+type Pool_Strategy int32
+
+const (
+	// LOWEST reuses the binding with the lowest address
+	Pool_LOWEST Pool_Strategy = 0
+	// RANDOM reuses a released binding picked at random
+	Pool_RANDOM Pool_Strategy = 1
+	// LEAST_RECENTLY_RELEASED reuses the binding released longest ago, maximizing the time before an address is reused
+	Pool_LEAST_RECENTLY_RELEASED Pool_Strategy = 2
+	// STICKY reuses the binding last held by the bind's affinity key, falling back to LOWEST
+	Pool_STICKY Pool_Strategy = 3
+)
+
+var Pool_Strategy_name = map[int32]string{
+	0: "LOWEST",
+	1: "RANDOM",
+	2: "LEAST_RECENTLY_RELEASED",
+	3: "STICKY",
+}
+var Pool_Strategy_value = map[string]int32{
+	"LOWEST":                  0,
+	"RANDOM":                  1,
+	"LEAST_RECENTLY_RELEASED": 2,
+	"STICKY":                  3,
+}
+
+func (x Pool_Strategy) String() string {
+	return proto.EnumName(Pool_Strategy_name, int32(x))
+}
+func (Pool_Strategy) EnumDescriptor() ([]byte, []int) { return fileDescriptorPostal, []int{3, 1} }
+
+type Event_Type int32
+
+const (
+	Event_CREATED  Event_Type = 0
+	Event_UPDATED  Event_Type = 1
+	Event_BOUND    Event_Type = 2
+	Event_RELEASED Event_Type = 3
+	// EXPIRED bindings were released because their lease was not renewed
+	Event_EXPIRED Event_Type = 4
+	Event_DELETED Event_Type = 5
+)
+
+var Event_Type_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "BOUND",
+	3: "RELEASED",
+	4: "EXPIRED",
+	5: "DELETED",
+}
+var Event_Type_value = map[string]int32{
+	"CREATED":  0,
+	"UPDATED":  1,
+	"BOUND":    2,
+	"RELEASED": 3,
+	"EXPIRED":  4,
+	"DELETED":  5,
+}
+
+func (x Event_Type) String() string {
+	return proto.EnumName(Event_Type_name, int32(x))
+}
+func (Event_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorPostal, []int{5, 0} }
+
 type Error struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
@@ -128,6 +214,9 @@ type Pool struct {
 	// The maximum number of addresses that the pool should allocate
 	MaximumAddresses uint64    `protobuf:"varint,3,opt,name=maximumAddresses,proto3" json:"maximumAddresses,omitempty"`
 	Type             Pool_Type `protobuf:"varint,4,opt,name=type,proto3,enum=api.Pool_Type" json:"type,omitempty"`
+	// Seconds a released binding is kept from being bound again, unless the bind ignores quarantine
+	Quarantine int64         `protobuf:"varint,5,opt,name=quarantine,proto3" json:"quarantine,omitempty"`
+	Strategy   Pool_Strategy `protobuf:"varint,6,opt,name=strategy,proto3,enum=api.Pool_Strategy" json:"strategy,omitempty"`
 }
 
 func (m *Pool) Reset()                    { *m = Pool{} }
@@ -167,6 +256,14 @@ type Binding struct {
 	AllocateTime int64             `protobuf:"varint,5,opt,name=allocateTime,proto3" json:"allocateTime,omitempty"`
 	BindTime     int64             `protobuf:"varint,6,opt,name=bindTime,proto3" json:"bindTime,omitempty"`
 	ReleaseTime  int64             `protobuf:"varint,7,opt,name=releaseTime,proto3" json:"releaseTime,omitempty"`
+	// Lease ttl in seconds, a binding with a ttl is released if it is not renewed in time
+	Ttl int64 `protobuf:"varint,8,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time until which a released binding is quarantined, in unix nanoseconds
+	QuarantineUntil int64 `protobuf:"varint,9,opt,name=quarantineUntil,proto3" json:"quarantineUntil,omitempty"`
+	// Affinity key of the last bind, see Pool.Strategy
+	AffinityKey string `protobuf:"bytes,10,opt,name=affinityKey,proto3" json:"affinityKey,omitempty"`
+	// Owner the binding was bound for, see BindAddressRequest.owner
+	Owner string `protobuf:"bytes,11,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (m *Binding) Reset()                    { *m = Binding{} }
@@ -188,16 +285,125 @@ func (m *Binding) GetAnnotations() map[string]string {
 	return nil
 }
 
+type Event struct {
+	Type Event_Type `protobuf:"varint,1,opt,name=type,proto3,enum=api.Event_Type" json:"type,omitempty"`
+	// Only the resource the event is about is set, deleted resources only carry their IDs
+	Network *Network `protobuf:"bytes,2,opt,name=network" json:"network,omitempty"`
+	Pool    *Pool    `protobuf:"bytes,3,opt,name=pool" json:"pool,omitempty"`
+	Binding *Binding `protobuf:"bytes,4,opt,name=binding" json:"binding,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{5} }
+
+func (m *Event) GetNetwork() *Network {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *Event) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+func (m *Event) GetBinding() *Binding {
+	if m != nil {
+		return m.Binding
+	}
+	return nil
+}
+
+// Role grants the rpcs of its rules to the callers it is bound to
+type Role struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Names of the callers the role is bound to
+	Users []string `protobuf:"bytes,2,rep,name=users" json:"users,omitempty"`
+	// Groups of the callers the role is bound to
+	Groups []string     `protobuf:"bytes,3,rep,name=groups" json:"groups,omitempty"`
+	Rules  []*Role_Rule `protobuf:"bytes,4,rep,name=rules" json:"rules,omitempty"`
+}
+
+func (m *Role) Reset()                    { *m = Role{} }
+func (m *Role) String() string            { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()               {}
+func (*Role) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{6} }
+
+func (m *Role) GetRules() []*Role_Rule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// Rule allows rpcs on a set of networks and pools
+type Role_Rule struct {
+	// Rpc methods the rule allows, such as BindAddress, or * for all of them
+	Methods []string `protobuf:"bytes,1,rep,name=methods" json:"methods,omitempty"`
+	// Networks the rule is scoped to, all networks if empty
+	Networks []string `protobuf:"bytes,2,rep,name=networks" json:"networks,omitempty"`
+	// Pools the rule is scoped to, all pools if empty
+	Pools []string `protobuf:"bytes,3,rep,name=pools" json:"pools,omitempty"`
+}
+
+func (m *Role_Rule) Reset()                    { *m = Role_Rule{} }
+func (m *Role_Rule) String() string            { return proto.CompactTextString(m) }
+func (*Role_Rule) ProtoMessage()               {}
+func (*Role_Rule) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{6, 0} }
+
+// AuditEntry records a call of a mutating rpc
+type AuditEntry struct {
+	ID string `protobuf:"bytes,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
+	// Time the rpc returned, in unix nanoseconds
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Rpc method, such as BindAddress
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// Name of the caller, empty for anonymous callers
+	Identity string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	Groups   []string `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
+	// Request of the rpc in JSON
+	Request string `protobuf:"bytes,6,opt,name=request,proto3" json:"request,omitempty"`
+	// Status code of the rpc, OK if it succeeded
+	Code string `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
+	// Error of a failed rpc
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// Store revision of the last change the rpc made, unset if it made none
+	Revision int64 `protobuf:"varint,9,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Network and pool the rpc targeted or created
+	NetworkID string `protobuf:"bytes,10,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	PoolID    string `protobuf:"bytes,11,opt,name=poolID,proto3" json:"poolID,omitempty"`
+	// Addresses the rpc allocated, bound, renewed or released
+	Addresses []string `protobuf:"bytes,12,rep,name=addresses" json:"addresses,omitempty"`
+	// Set if the response of an earlier call with the same idempotency key was
+	// replayed, the rpc changed nothing then
+	Replayed bool `protobuf:"varint,13,opt,name=replayed,proto3" json:"replayed,omitempty"`
+}
+
+func (m *AuditEntry) Reset()                    { *m = AuditEntry{} }
+func (m *AuditEntry) String() string            { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()               {}
+func (*AuditEntry) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{7} }
+
 type NetworkRangeRequest struct {
-	ID      string            `protobuf:"bytes,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
+	// Maximum number of networks to return, all of them if unset
 	Size_   int32             `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	Filters map[string]string `protobuf:"bytes,3,rep,name=filters" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Token of the page to return, from a previous response
+	ContinuationToken string `protobuf:"bytes,4,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
+	// Selector expression the networks must match, see postal.Selector
+	Selector string `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
 }
 
 func (m *NetworkRangeRequest) Reset()                    { *m = NetworkRangeRequest{} }
 func (m *NetworkRangeRequest) String() string            { return proto.CompactTextString(m) }
 func (*NetworkRangeRequest) ProtoMessage()               {}
-func (*NetworkRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{5} }
+func (*NetworkRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{8} }
 
 func (m *NetworkRangeRequest) GetFilters() map[string]string {
 	if m != nil {
@@ -209,12 +415,14 @@ func (m *NetworkRangeRequest) GetFilters() map[string]string {
 type NetworkRangeResponse struct {
 	Networks []*Network `protobuf:"bytes,1,rep,name=networks" json:"networks,omitempty"`
 	Size_    int32      `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Token of the next page, empty once all networks were returned
+	ContinuationToken string `protobuf:"bytes,3,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
 }
 
 func (m *NetworkRangeResponse) Reset()                    { *m = NetworkRangeResponse{} }
 func (m *NetworkRangeResponse) String() string            { return proto.CompactTextString(m) }
 func (*NetworkRangeResponse) ProtoMessage()               {}
-func (*NetworkRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{6} }
+func (*NetworkRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{9} }
 
 func (m *NetworkRangeResponse) GetNetworks() []*Network {
 	if m != nil {
@@ -231,7 +439,7 @@ type NetworkAddRequest struct {
 func (m *NetworkAddRequest) Reset()                    { *m = NetworkAddRequest{} }
 func (m *NetworkAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*NetworkAddRequest) ProtoMessage()               {}
-func (*NetworkAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{7} }
+func (*NetworkAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{10} }
 
 func (m *NetworkAddRequest) GetAnnotations() map[string]string {
 	if m != nil {
@@ -247,7 +455,7 @@ type NetworkAddResponse struct {
 func (m *NetworkAddResponse) Reset()                    { *m = NetworkAddResponse{} }
 func (m *NetworkAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*NetworkAddResponse) ProtoMessage()               {}
-func (*NetworkAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{8} }
+func (*NetworkAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{11} }
 
 func (m *NetworkAddResponse) GetNetwork() *Network {
 	if m != nil {
@@ -258,12 +466,14 @@ func (m *NetworkAddResponse) GetNetwork() *Network {
 
 type NetworkRemoveRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
+	// Remove the network even if addresses are bound, hard releasing them
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
 func (m *NetworkRemoveRequest) Reset()                    { *m = NetworkRemoveRequest{} }
 func (m *NetworkRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*NetworkRemoveRequest) ProtoMessage()               {}
-func (*NetworkRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{9} }
+func (*NetworkRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{12} }
 
 type NetworkRemoveResponse struct {
 }
@@ -271,18 +481,23 @@ type NetworkRemoveResponse struct {
 func (m *NetworkRemoveResponse) Reset()                    { *m = NetworkRemoveResponse{} }
 func (m *NetworkRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*NetworkRemoveResponse) ProtoMessage()               {}
-func (*NetworkRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{10} }
+func (*NetworkRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{13} }
 
 type PoolRangeRequest struct {
-	ID      *Pool_PoolID      `protobuf:"bytes,1,opt,name=ID,json=iD" json:"ID,omitempty"`
+	ID *Pool_PoolID `protobuf:"bytes,1,opt,name=ID,json=iD" json:"ID,omitempty"`
+	// Maximum number of pools to return, all of them if unset
 	Size_   int32             `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	Filters map[string]string `protobuf:"bytes,3,rep,name=filters" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Token of the page to return, from a previous response
+	ContinuationToken string `protobuf:"bytes,4,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
+	// Selector expression the pools must match, see postal.Selector
+	Selector string `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
 }
 
 func (m *PoolRangeRequest) Reset()                    { *m = PoolRangeRequest{} }
 func (m *PoolRangeRequest) String() string            { return proto.CompactTextString(m) }
 func (*PoolRangeRequest) ProtoMessage()               {}
-func (*PoolRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{11} }
+func (*PoolRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{14} }
 
 func (m *PoolRangeRequest) GetID() *Pool_PoolID {
 	if m != nil {
@@ -301,12 +516,14 @@ func (m *PoolRangeRequest) GetFilters() map[string]string {
 type PoolRangeResponse struct {
 	Pools []*Pool `protobuf:"bytes,1,rep,name=pools" json:"pools,omitempty"`
 	Size_ int32   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Token of the next page, empty once all pools were returned
+	ContinuationToken string `protobuf:"bytes,3,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
 }
 
 func (m *PoolRangeResponse) Reset()                    { *m = PoolRangeResponse{} }
 func (m *PoolRangeResponse) String() string            { return proto.CompactTextString(m) }
 func (*PoolRangeResponse) ProtoMessage()               {}
-func (*PoolRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{12} }
+func (*PoolRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{15} }
 
 func (m *PoolRangeResponse) GetPools() []*Pool {
 	if m != nil {
@@ -320,12 +537,15 @@ type PoolAddRequest struct {
 	Annotations map[string]string `protobuf:"bytes,2,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Maximum     uint64            `protobuf:"varint,3,opt,name=maximum,proto3" json:"maximum,omitempty"`
 	Type        Pool_Type         `protobuf:"varint,4,opt,name=type,proto3,enum=api.Pool_Type" json:"type,omitempty"`
+	// Seconds released bindings are quarantined for
+	Quarantine int64         `protobuf:"varint,5,opt,name=quarantine,proto3" json:"quarantine,omitempty"`
+	Strategy   Pool_Strategy `protobuf:"varint,6,opt,name=strategy,proto3,enum=api.Pool_Strategy" json:"strategy,omitempty"`
 }
 
 func (m *PoolAddRequest) Reset()                    { *m = PoolAddRequest{} }
 func (m *PoolAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*PoolAddRequest) ProtoMessage()               {}
-func (*PoolAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{13} }
+func (*PoolAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{16} }
 
 func (m *PoolAddRequest) GetAnnotations() map[string]string {
 	if m != nil {
@@ -341,7 +561,7 @@ type PoolAddResponse struct {
 func (m *PoolAddResponse) Reset()                    { *m = PoolAddResponse{} }
 func (m *PoolAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*PoolAddResponse) ProtoMessage()               {}
-func (*PoolAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{14} }
+func (*PoolAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{17} }
 
 func (m *PoolAddResponse) GetPool() *Pool {
 	if m != nil {
@@ -352,12 +572,14 @@ func (m *PoolAddResponse) GetPool() *Pool {
 
 type PoolRemoveRequest struct {
 	ID *Pool_PoolID `protobuf:"bytes,1,opt,name=ID,json=iD" json:"ID,omitempty"`
+	// Remove the pool even if addresses are bound, hard releasing them
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
 func (m *PoolRemoveRequest) Reset()                    { *m = PoolRemoveRequest{} }
 func (m *PoolRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*PoolRemoveRequest) ProtoMessage()               {}
-func (*PoolRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{15} }
+func (*PoolRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{18} }
 
 func (m *PoolRemoveRequest) GetID() *Pool_PoolID {
 	if m != nil {
@@ -372,7 +594,7 @@ type PoolRemoveResponse struct {
 func (m *PoolRemoveResponse) Reset()                    { *m = PoolRemoveResponse{} }
 func (m *PoolRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*PoolRemoveResponse) ProtoMessage()               {}
-func (*PoolRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{16} }
+func (*PoolRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{19} }
 
 type PoolSetMaxRequest struct {
 	PoolID  *Pool_PoolID `protobuf:"bytes,1,opt,name=poolID" json:"poolID,omitempty"`
@@ -382,7 +604,7 @@ type PoolSetMaxRequest struct {
 func (m *PoolSetMaxRequest) Reset()                    { *m = PoolSetMaxRequest{} }
 func (m *PoolSetMaxRequest) String() string            { return proto.CompactTextString(m) }
 func (*PoolSetMaxRequest) ProtoMessage()               {}
-func (*PoolSetMaxRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{17} }
+func (*PoolSetMaxRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{20} }
 
 func (m *PoolSetMaxRequest) GetPoolID() *Pool_PoolID {
 	if m != nil {
@@ -397,18 +619,23 @@ type PoolSetMaxResponse struct {
 func (m *PoolSetMaxResponse) Reset()                    { *m = PoolSetMaxResponse{} }
 func (m *PoolSetMaxResponse) String() string            { return proto.CompactTextString(m) }
 func (*PoolSetMaxResponse) ProtoMessage()               {}
-func (*PoolSetMaxResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{18} }
+func (*PoolSetMaxResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{21} }
 
 type BindingRangeRequest struct {
-	NetworkID string            `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	Size_     int32             `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Filters   map[string]string `protobuf:"bytes,4,rep,name=filters" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// Maximum number of bindings to return, all of them if unset
+	Size_   int32             `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Filters map[string]string `protobuf:"bytes,4,rep,name=filters" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Token of the page to return, from a previous response
+	ContinuationToken string `protobuf:"bytes,5,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
+	// Selector expression the bindings must match, see postal.Selector
+	Selector string `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
 }
 
 func (m *BindingRangeRequest) Reset()                    { *m = BindingRangeRequest{} }
 func (m *BindingRangeRequest) String() string            { return proto.CompactTextString(m) }
 func (*BindingRangeRequest) ProtoMessage()               {}
-func (*BindingRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{19} }
+func (*BindingRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{22} }
 
 func (m *BindingRangeRequest) GetFilters() map[string]string {
 	if m != nil {
@@ -420,12 +647,14 @@ func (m *BindingRangeRequest) GetFilters() map[string]string {
 type BindingRangeResponse struct {
 	Bindings []*Binding `protobuf:"bytes,1,rep,name=bindings" json:"bindings,omitempty"`
 	Size_    int32      `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Token of the next page, empty once all bindings were returned
+	ContinuationToken string `protobuf:"bytes,3,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
 }
 
 func (m *BindingRangeResponse) Reset()                    { *m = BindingRangeResponse{} }
 func (m *BindingRangeResponse) String() string            { return proto.CompactTextString(m) }
 func (*BindingRangeResponse) ProtoMessage()               {}
-func (*BindingRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{20} }
+func (*BindingRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{23} }
 
 func (m *BindingRangeResponse) GetBindings() []*Binding {
 	if m != nil {
@@ -437,12 +666,14 @@ func (m *BindingRangeResponse) GetBindings() []*Binding {
 type AllocateAddressRequest struct {
 	PoolID  *Pool_PoolID `protobuf:"bytes,1,opt,name=poolID" json:"poolID,omitempty"`
 	Address string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *AllocateAddressRequest) Reset()                    { *m = AllocateAddressRequest{} }
 func (m *AllocateAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*AllocateAddressRequest) ProtoMessage()               {}
-func (*AllocateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{21} }
+func (*AllocateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{24} }
 
 func (m *AllocateAddressRequest) GetPoolID() *Pool_PoolID {
 	if m != nil {
@@ -458,7 +689,7 @@ type AllocateAddressResponse struct {
 func (m *AllocateAddressResponse) Reset()                    { *m = AllocateAddressResponse{} }
 func (m *AllocateAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*AllocateAddressResponse) ProtoMessage()               {}
-func (*AllocateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{22} }
+func (*AllocateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{25} }
 
 func (m *AllocateAddressResponse) GetBinding() *Binding {
 	if m != nil {
@@ -470,13 +701,15 @@ func (m *AllocateAddressResponse) GetBinding() *Binding {
 type BulkAllocateAddressRequest struct {
 	PoolID *Pool_PoolID `protobuf:"bytes,1,opt,name=poolID" json:"poolID,omitempty"`
 	Cidr   string       `protobuf:"bytes,2,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	// Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *BulkAllocateAddressRequest) Reset()         { *m = BulkAllocateAddressRequest{} }
 func (m *BulkAllocateAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*BulkAllocateAddressRequest) ProtoMessage()    {}
 func (*BulkAllocateAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorPostal, []int{23}
+	return fileDescriptorPostal, []int{26}
 }
 
 func (m *BulkAllocateAddressRequest) GetPoolID() *Pool_PoolID {
@@ -495,7 +728,7 @@ func (m *BulkAllocateAddressResponse) Reset()         { *m = BulkAllocateAddress
 func (m *BulkAllocateAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*BulkAllocateAddressResponse) ProtoMessage()    {}
 func (*BulkAllocateAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorPostal, []int{24}
+	return fileDescriptorPostal, []int{27}
 }
 
 func (m *BulkAllocateAddressResponse) GetBindings() []*Binding {
@@ -516,12 +749,23 @@ type BindAddressRequest struct {
 	PoolID      *Pool_PoolID      `protobuf:"bytes,1,opt,name=poolID" json:"poolID,omitempty"`
 	Address     string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Annotations map[string]string `protobuf:"bytes,3,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional lease ttl in seconds, the binding must be renewed with RenewBinding before it expires
+	Ttl int64 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Bind quarantined bindings as well, forcing the reuse of recently released addresses
+	IgnoreQuarantine bool `protobuf:"varint,5,opt,name=ignoreQuarantine,proto3" json:"ignoreQuarantine,omitempty"`
+	// Key the binding is recorded under, STICKY pools rebind the address last held by the same key
+	AffinityKey string `protobuf:"bytes,6,opt,name=affinityKey,proto3" json:"affinityKey,omitempty"`
+	// Identity of the owner, such as a host or workload ID. Binds are idempotent per owner: the binding
+	// the owner holds in the pool is returned, or the owner's previous address bound again if it is free.
+	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *BindAddressRequest) Reset()                    { *m = BindAddressRequest{} }
 func (m *BindAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*BindAddressRequest) ProtoMessage()               {}
-func (*BindAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{25} }
+func (*BindAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{28} }
 
 func (m *BindAddressRequest) GetPoolID() *Pool_PoolID {
 	if m != nil {
@@ -544,7 +788,7 @@ type BindAddressResponse struct {
 func (m *BindAddressResponse) Reset()                    { *m = BindAddressResponse{} }
 func (m *BindAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*BindAddressResponse) ProtoMessage()               {}
-func (*BindAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{26} }
+func (*BindAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{29} }
 
 func (m *BindAddressResponse) GetBinding() *Binding {
 	if m != nil {
@@ -553,17 +797,107 @@ func (m *BindAddressResponse) GetBinding() *Binding {
 	return nil
 }
 
+type BindAddressesRequest struct {
+	PoolID *Pool_PoolID `protobuf:"bytes,1,opt,name=poolID" json:"poolID,omitempty"`
+	// Number of addresses to bind
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Annotations of every binding
+	Annotations map[string]string `protobuf:"bytes,3,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations of each binding in turn, merged over annotations, there may be fewer than count
+	BindingAnnotations []*BindAddressesRequest_Annotations `protobuf:"bytes,4,rep,name=bindingAnnotations" json:"bindingAnnotations,omitempty"`
+	// Optional lease ttl in seconds of every binding, see BindAddressRequest.ttl
+	Ttl int64 `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Bind none of the addresses unless all of them can be bound. Otherwise the addresses are bound
+	// one at a time if they cannot be bound together, and the failures reported in errors
+	Atomic bool `protobuf:"varint,6,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// Bind quarantined bindings as well, forcing the reuse of recently released addresses
+	IgnoreQuarantine bool `protobuf:"varint,7,opt,name=ignoreQuarantine,proto3" json:"ignoreQuarantine,omitempty"`
+	// Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (m *BindAddressesRequest) Reset()                    { *m = BindAddressesRequest{} }
+func (m *BindAddressesRequest) String() string            { return proto.CompactTextString(m) }
+func (*BindAddressesRequest) ProtoMessage()               {}
+func (*BindAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{30} }
+
+func (m *BindAddressesRequest) GetPoolID() *Pool_PoolID {
+	if m != nil {
+		return m.PoolID
+	}
+	return nil
+}
+
+func (m *BindAddressesRequest) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *BindAddressesRequest) GetBindingAnnotations() []*BindAddressesRequest_Annotations {
+	if m != nil {
+		return m.BindingAnnotations
+	}
+	return nil
+}
+
+type BindAddressesRequest_Annotations struct {
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *BindAddressesRequest_Annotations) Reset()         { *m = BindAddressesRequest_Annotations{} }
+func (m *BindAddressesRequest_Annotations) String() string { return proto.CompactTextString(m) }
+func (*BindAddressesRequest_Annotations) ProtoMessage()    {}
+func (*BindAddressesRequest_Annotations) Descriptor() ([]byte, []int) {
+	return fileDescriptorPostal, []int{30, 1}
+}
+
+func (m *BindAddressesRequest_Annotations) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type BindAddressesResponse struct {
+	Bindings []*Binding `protobuf:"bytes,1,rep,name=bindings" json:"bindings,omitempty"`
+	// Errors of the bindings which failed, by their decimal index in the request
+	Errors map[string]*Error `protobuf:"bytes,2,rep,name=errors" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *BindAddressesResponse) Reset()                    { *m = BindAddressesResponse{} }
+func (m *BindAddressesResponse) String() string            { return proto.CompactTextString(m) }
+func (*BindAddressesResponse) ProtoMessage()               {}
+func (*BindAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{31} }
+
+func (m *BindAddressesResponse) GetBindings() []*Binding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+func (m *BindAddressesResponse) GetErrors() map[string]*Error {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 type ReleaseAddressRequest struct {
 	PoolID    *Pool_PoolID `protobuf:"bytes,1,opt,name=poolID" json:"poolID,omitempty"`
 	BindingID string       `protobuf:"bytes,2,opt,name=bindingID,proto3" json:"bindingID,omitempty"`
 	Address   string       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	Hard      bool         `protobuf:"varint,4,opt,name=hard,proto3" json:"hard,omitempty"`
+	// Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *ReleaseAddressRequest) Reset()                    { *m = ReleaseAddressRequest{} }
 func (m *ReleaseAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReleaseAddressRequest) ProtoMessage()               {}
-func (*ReleaseAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{27} }
+func (*ReleaseAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{32} }
 
 func (m *ReleaseAddressRequest) GetPoolID() *Pool_PoolID {
 	if m != nil {
@@ -573,12 +907,241 @@ func (m *ReleaseAddressRequest) GetPoolID() *Pool_PoolID {
 }
 
 type ReleaseAddressResponse struct {
+	// The binding as it was before it was released
+	Binding *Binding `protobuf:"bytes,1,opt,name=binding" json:"binding,omitempty"`
 }
 
 func (m *ReleaseAddressResponse) Reset()                    { *m = ReleaseAddressResponse{} }
 func (m *ReleaseAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReleaseAddressResponse) ProtoMessage()               {}
-func (*ReleaseAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{28} }
+func (*ReleaseAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{33} }
+
+func (m *ReleaseAddressResponse) GetBinding() *Binding {
+	if m != nil {
+		return m.Binding
+	}
+	return nil
+}
+
+type RenewBindingRequest struct {
+	PoolID    *Pool_PoolID `protobuf:"bytes,1,opt,name=poolID" json:"poolID,omitempty"`
+	BindingID string       `protobuf:"bytes,2,opt,name=bindingID,proto3" json:"bindingID,omitempty"`
+}
+
+func (m *RenewBindingRequest) Reset()                    { *m = RenewBindingRequest{} }
+func (m *RenewBindingRequest) String() string            { return proto.CompactTextString(m) }
+func (*RenewBindingRequest) ProtoMessage()               {}
+func (*RenewBindingRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{34} }
+
+func (m *RenewBindingRequest) GetPoolID() *Pool_PoolID {
+	if m != nil {
+		return m.PoolID
+	}
+	return nil
+}
+
+type RenewBindingResponse struct {
+	Binding *Binding `protobuf:"bytes,1,opt,name=binding" json:"binding,omitempty"`
+}
+
+func (m *RenewBindingResponse) Reset()                    { *m = RenewBindingResponse{} }
+func (m *RenewBindingResponse) String() string            { return proto.CompactTextString(m) }
+func (*RenewBindingResponse) ProtoMessage()               {}
+func (*RenewBindingResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{35} }
+
+func (m *RenewBindingResponse) GetBinding() *Binding {
+	if m != nil {
+		return m.Binding
+	}
+	return nil
+}
+
+type AddressHistoryRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Maximum number of bindings to return, all of them if unset
+	Size_ int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Token of the page to return, from a previous response
+	ContinuationToken string `protobuf:"bytes,4,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
+}
+
+func (m *AddressHistoryRequest) Reset()                    { *m = AddressHistoryRequest{} }
+func (m *AddressHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddressHistoryRequest) ProtoMessage()               {}
+func (*AddressHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{36} }
+
+type AddressHistoryResponse struct {
+	// Bindings as they were released, or as they are while still bound
+	Bindings []*Binding `protobuf:"bytes,1,rep,name=bindings" json:"bindings,omitempty"`
+	Size_    int32      `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Token of the next page, empty once all bindings were returned
+	ContinuationToken string `protobuf:"bytes,3,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
+}
+
+func (m *AddressHistoryResponse) Reset()                    { *m = AddressHistoryResponse{} }
+func (m *AddressHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddressHistoryResponse) ProtoMessage()               {}
+func (*AddressHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{37} }
+
+func (m *AddressHistoryResponse) GetBindings() []*Binding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+type WatchRequest struct {
+	Filters map[string]string `protobuf:"bytes,1,rep,name=filters" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Revision to start watching at, the watch starts at the current revision if unset
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Selector expression the changed resources must match, see postal.Selector
+	Selector string `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (m *WatchRequest) Reset()                    { *m = WatchRequest{} }
+func (m *WatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()               {}
+func (*WatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{38} }
+
+func (m *WatchRequest) GetFilters() map[string]string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type WatchResponse struct {
+	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	// Revision of the events, the first response of a watch without a revision
+	// carries no events and the revision the watch started after
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *WatchResponse) Reset()                    { *m = WatchResponse{} }
+func (m *WatchResponse) String() string            { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()               {}
+func (*WatchResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{39} }
+
+func (m *WatchResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type RoleRangeRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *RoleRangeRequest) Reset()                    { *m = RoleRangeRequest{} }
+func (m *RoleRangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*RoleRangeRequest) ProtoMessage()               {}
+func (*RoleRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{40} }
+
+type RoleRangeResponse struct {
+	Roles []*Role `protobuf:"bytes,1,rep,name=roles" json:"roles,omitempty"`
+}
+
+func (m *RoleRangeResponse) Reset()                    { *m = RoleRangeResponse{} }
+func (m *RoleRangeResponse) String() string            { return proto.CompactTextString(m) }
+func (*RoleRangeResponse) ProtoMessage()               {}
+func (*RoleRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{41} }
+
+func (m *RoleRangeResponse) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type RoleSetRequest struct {
+	Role *Role `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *RoleSetRequest) Reset()                    { *m = RoleSetRequest{} }
+func (m *RoleSetRequest) String() string            { return proto.CompactTextString(m) }
+func (*RoleSetRequest) ProtoMessage()               {}
+func (*RoleSetRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{42} }
+
+func (m *RoleSetRequest) GetRole() *Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type RoleSetResponse struct {
+	Role *Role `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *RoleSetResponse) Reset()                    { *m = RoleSetResponse{} }
+func (m *RoleSetResponse) String() string            { return proto.CompactTextString(m) }
+func (*RoleSetResponse) ProtoMessage()               {}
+func (*RoleSetResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{43} }
+
+func (m *RoleSetResponse) GetRole() *Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type RoleRemoveRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *RoleRemoveRequest) Reset()                    { *m = RoleRemoveRequest{} }
+func (m *RoleRemoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*RoleRemoveRequest) ProtoMessage()               {}
+func (*RoleRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{44} }
+
+type RoleRemoveResponse struct {
+}
+
+func (m *RoleRemoveResponse) Reset()                    { *m = RoleRemoveResponse{} }
+func (m *RoleRemoveResponse) String() string            { return proto.CompactTextString(m) }
+func (*RoleRemoveResponse) ProtoMessage()               {}
+func (*RoleRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{45} }
+
+type AuditRangeRequest struct {
+	// Only entries recorded at or after start, in unix nanoseconds
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Only entries recorded before end, in unix nanoseconds, all of them if unset
+	End       int64  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	NetworkID string `protobuf:"bytes,3,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	PoolID    string `protobuf:"bytes,4,opt,name=poolID,proto3" json:"poolID,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Identity  string `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	Method    string `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	// Maximum number of entries to return, all of them if unset
+	Size_ int32 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	// Token of the page to return, from a previous response
+	ContinuationToken string `protobuf:"bytes,9,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
+}
+
+func (m *AuditRangeRequest) Reset()                    { *m = AuditRangeRequest{} }
+func (m *AuditRangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuditRangeRequest) ProtoMessage()               {}
+func (*AuditRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{46} }
+
+type AuditRangeResponse struct {
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	Size_   int32         `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Token of the next page, empty once all entries were returned
+	ContinuationToken string `protobuf:"bytes,3,opt,name=continuationToken,proto3" json:"continuationToken,omitempty"`
+}
+
+func (m *AuditRangeResponse) Reset()                    { *m = AuditRangeResponse{} }
+func (m *AuditRangeResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuditRangeResponse) ProtoMessage()               {}
+func (*AuditRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptorPostal, []int{47} }
+
+func (m *AuditRangeResponse) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
 
 func init() {
 	proto.RegisterType((*Error)(nil), "api.Error")
@@ -587,6 +1150,10 @@ func init() {
 	proto.RegisterType((*Pool)(nil), "api.Pool")
 	proto.RegisterType((*Pool_PoolID)(nil), "api.Pool.PoolID")
 	proto.RegisterType((*Binding)(nil), "api.Binding")
+	proto.RegisterType((*Event)(nil), "api.Event")
+	proto.RegisterType((*Role)(nil), "api.Role")
+	proto.RegisterType((*Role_Rule)(nil), "api.Role.Rule")
+	proto.RegisterType((*AuditEntry)(nil), "api.AuditEntry")
 	proto.RegisterType((*NetworkRangeRequest)(nil), "api.NetworkRangeRequest")
 	proto.RegisterType((*NetworkRangeResponse)(nil), "api.NetworkRangeResponse")
 	proto.RegisterType((*NetworkAddRequest)(nil), "api.NetworkAddRequest")
@@ -609,9 +1176,28 @@ func init() {
 	proto.RegisterType((*BulkAllocateAddressResponse)(nil), "api.BulkAllocateAddressResponse")
 	proto.RegisterType((*BindAddressRequest)(nil), "api.BindAddressRequest")
 	proto.RegisterType((*BindAddressResponse)(nil), "api.BindAddressResponse")
+	proto.RegisterType((*BindAddressesRequest)(nil), "api.BindAddressesRequest")
+	proto.RegisterType((*BindAddressesRequest_Annotations)(nil), "api.BindAddressesRequest.Annotations")
+	proto.RegisterType((*BindAddressesResponse)(nil), "api.BindAddressesResponse")
 	proto.RegisterType((*ReleaseAddressRequest)(nil), "api.ReleaseAddressRequest")
 	proto.RegisterType((*ReleaseAddressResponse)(nil), "api.ReleaseAddressResponse")
+	proto.RegisterType((*RenewBindingRequest)(nil), "api.RenewBindingRequest")
+	proto.RegisterType((*RenewBindingResponse)(nil), "api.RenewBindingResponse")
+	proto.RegisterType((*AddressHistoryRequest)(nil), "api.AddressHistoryRequest")
+	proto.RegisterType((*AddressHistoryResponse)(nil), "api.AddressHistoryResponse")
+	proto.RegisterType((*WatchRequest)(nil), "api.WatchRequest")
+	proto.RegisterType((*WatchResponse)(nil), "api.WatchResponse")
+	proto.RegisterType((*RoleRangeRequest)(nil), "api.RoleRangeRequest")
+	proto.RegisterType((*RoleRangeResponse)(nil), "api.RoleRangeResponse")
+	proto.RegisterType((*RoleSetRequest)(nil), "api.RoleSetRequest")
+	proto.RegisterType((*RoleSetResponse)(nil), "api.RoleSetResponse")
+	proto.RegisterType((*RoleRemoveRequest)(nil), "api.RoleRemoveRequest")
+	proto.RegisterType((*RoleRemoveResponse)(nil), "api.RoleRemoveResponse")
+	proto.RegisterType((*AuditRangeRequest)(nil), "api.AuditRangeRequest")
+	proto.RegisterType((*AuditRangeResponse)(nil), "api.AuditRangeResponse")
 	proto.RegisterEnum("api.Pool_Type", Pool_Type_name, Pool_Type_value)
+	proto.RegisterEnum("api.Pool_Strategy", Pool_Strategy_name, Pool_Strategy_value)
+	proto.RegisterEnum("api.Event_Type", Event_Type_name, Event_Type_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -636,7 +1222,15 @@ type PostalClient interface {
 	AllocateAddress(ctx context.Context, in *AllocateAddressRequest, opts ...grpc.CallOption) (*AllocateAddressResponse, error)
 	BulkAllocateAddress(ctx context.Context, in *BulkAllocateAddressRequest, opts ...grpc.CallOption) (*BulkAllocateAddressResponse, error)
 	BindAddress(ctx context.Context, in *BindAddressRequest, opts ...grpc.CallOption) (*BindAddressResponse, error)
+	BindAddresses(ctx context.Context, in *BindAddressesRequest, opts ...grpc.CallOption) (*BindAddressesResponse, error)
 	ReleaseAddress(ctx context.Context, in *ReleaseAddressRequest, opts ...grpc.CallOption) (*ReleaseAddressResponse, error)
+	RenewBinding(ctx context.Context, in *RenewBindingRequest, opts ...grpc.CallOption) (*RenewBindingResponse, error)
+	AddressHistory(ctx context.Context, in *AddressHistoryRequest, opts ...grpc.CallOption) (*AddressHistoryResponse, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Postal_WatchClient, error)
+	RoleRange(ctx context.Context, in *RoleRangeRequest, opts ...grpc.CallOption) (*RoleRangeResponse, error)
+	RoleSet(ctx context.Context, in *RoleSetRequest, opts ...grpc.CallOption) (*RoleSetResponse, error)
+	RoleRemove(ctx context.Context, in *RoleRemoveRequest, opts ...grpc.CallOption) (*RoleRemoveResponse, error)
+	AuditRange(ctx context.Context, in *AuditRangeRequest, opts ...grpc.CallOption) (*AuditRangeResponse, error)
 }
 
 type postalClient struct {
@@ -746,6 +1340,15 @@ func (c *postalClient) BindAddress(ctx context.Context, in *BindAddressRequest,
 	return out, nil
 }
 
+func (c *postalClient) BindAddresses(ctx context.Context, in *BindAddressesRequest, opts ...grpc.CallOption) (*BindAddressesResponse, error) {
+	out := new(BindAddressesResponse)
+	err := grpc.Invoke(ctx, "/api.Postal/BindAddresses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postalClient) ReleaseAddress(ctx context.Context, in *ReleaseAddressRequest, opts ...grpc.CallOption) (*ReleaseAddressResponse, error) {
 	out := new(ReleaseAddressResponse)
 	err := grpc.Invoke(ctx, "/api.Postal/ReleaseAddress", in, out, c.cc, opts...)
@@ -755,50 +1358,144 @@ func (c *postalClient) ReleaseAddress(ctx context.Context, in *ReleaseAddressReq
 	return out, nil
 }
 
-// Server API for Postal service
-
-type PostalServer interface {
-	NetworkRange(context.Context, *NetworkRangeRequest) (*NetworkRangeResponse, error)
-	NetworkAdd(context.Context, *NetworkAddRequest) (*NetworkAddResponse, error)
-	NetworkRemove(context.Context, *NetworkRemoveRequest) (*NetworkRemoveResponse, error)
-	PoolRange(context.Context, *PoolRangeRequest) (*PoolRangeResponse, error)
-	PoolAdd(context.Context, *PoolAddRequest) (*PoolAddResponse, error)
-	PoolRemove(context.Context, *PoolRemoveRequest) (*PoolRemoveResponse, error)
-	PoolSetMax(context.Context, *PoolSetMaxRequest) (*PoolSetMaxResponse, error)
-	BindingRange(context.Context, *BindingRangeRequest) (*BindingRangeResponse, error)
-	AllocateAddress(context.Context, *AllocateAddressRequest) (*AllocateAddressResponse, error)
-	BulkAllocateAddress(context.Context, *BulkAllocateAddressRequest) (*BulkAllocateAddressResponse, error)
-	BindAddress(context.Context, *BindAddressRequest) (*BindAddressResponse, error)
-	ReleaseAddress(context.Context, *ReleaseAddressRequest) (*ReleaseAddressResponse, error)
+func (c *postalClient) RenewBinding(ctx context.Context, in *RenewBindingRequest, opts ...grpc.CallOption) (*RenewBindingResponse, error) {
+	out := new(RenewBindingResponse)
+	err := grpc.Invoke(ctx, "/api.Postal/RenewBinding", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterPostalServer(s *grpc.Server, srv PostalServer) {
-	s.RegisterService(&_Postal_serviceDesc, srv)
+func (c *postalClient) AddressHistory(ctx context.Context, in *AddressHistoryRequest, opts ...grpc.CallOption) (*AddressHistoryResponse, error) {
+	out := new(AddressHistoryResponse)
+	err := grpc.Invoke(ctx, "/api.Postal/AddressHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Postal_NetworkRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkRangeRequest)
-	if err := dec(in); err != nil {
+func (c *postalClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Postal_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Postal_serviceDesc.Streams[0], c.cc, "/api.Postal/Watch", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PostalServer).NetworkRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Postal/NetworkRange",
+	x := &postalWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostalServer).NetworkRange(ctx, req.(*NetworkRangeRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _Postal_NetworkAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkAddRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
+type Postal_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type postalWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *postalWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *postalClient) RoleRange(ctx context.Context, in *RoleRangeRequest, opts ...grpc.CallOption) (*RoleRangeResponse, error) {
+	out := new(RoleRangeResponse)
+	err := grpc.Invoke(ctx, "/api.Postal/RoleRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postalClient) RoleSet(ctx context.Context, in *RoleSetRequest, opts ...grpc.CallOption) (*RoleSetResponse, error) {
+	out := new(RoleSetResponse)
+	err := grpc.Invoke(ctx, "/api.Postal/RoleSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postalClient) RoleRemove(ctx context.Context, in *RoleRemoveRequest, opts ...grpc.CallOption) (*RoleRemoveResponse, error) {
+	out := new(RoleRemoveResponse)
+	err := grpc.Invoke(ctx, "/api.Postal/RoleRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postalClient) AuditRange(ctx context.Context, in *AuditRangeRequest, opts ...grpc.CallOption) (*AuditRangeResponse, error) {
+	out := new(AuditRangeResponse)
+	err := grpc.Invoke(ctx, "/api.Postal/AuditRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Postal service
+
+type PostalServer interface {
+	NetworkRange(context.Context, *NetworkRangeRequest) (*NetworkRangeResponse, error)
+	NetworkAdd(context.Context, *NetworkAddRequest) (*NetworkAddResponse, error)
+	NetworkRemove(context.Context, *NetworkRemoveRequest) (*NetworkRemoveResponse, error)
+	PoolRange(context.Context, *PoolRangeRequest) (*PoolRangeResponse, error)
+	PoolAdd(context.Context, *PoolAddRequest) (*PoolAddResponse, error)
+	PoolRemove(context.Context, *PoolRemoveRequest) (*PoolRemoveResponse, error)
+	PoolSetMax(context.Context, *PoolSetMaxRequest) (*PoolSetMaxResponse, error)
+	BindingRange(context.Context, *BindingRangeRequest) (*BindingRangeResponse, error)
+	AllocateAddress(context.Context, *AllocateAddressRequest) (*AllocateAddressResponse, error)
+	BulkAllocateAddress(context.Context, *BulkAllocateAddressRequest) (*BulkAllocateAddressResponse, error)
+	BindAddress(context.Context, *BindAddressRequest) (*BindAddressResponse, error)
+	BindAddresses(context.Context, *BindAddressesRequest) (*BindAddressesResponse, error)
+	ReleaseAddress(context.Context, *ReleaseAddressRequest) (*ReleaseAddressResponse, error)
+	RenewBinding(context.Context, *RenewBindingRequest) (*RenewBindingResponse, error)
+	AddressHistory(context.Context, *AddressHistoryRequest) (*AddressHistoryResponse, error)
+	Watch(*WatchRequest, Postal_WatchServer) error
+	RoleRange(context.Context, *RoleRangeRequest) (*RoleRangeResponse, error)
+	RoleSet(context.Context, *RoleSetRequest) (*RoleSetResponse, error)
+	RoleRemove(context.Context, *RoleRemoveRequest) (*RoleRemoveResponse, error)
+	AuditRange(context.Context, *AuditRangeRequest) (*AuditRangeResponse, error)
+}
+
+func RegisterPostalServer(s *grpc.Server, srv PostalServer) {
+	s.RegisterService(&_Postal_serviceDesc, srv)
+}
+
+func _Postal_NetworkRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).NetworkRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/NetworkRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).NetworkRange(ctx, req.(*NetworkRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Postal_NetworkAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
 	if interceptor == nil {
 		return srv.(PostalServer).NetworkAdd(ctx, in)
 	}
@@ -974,6 +1671,24 @@ func _Postal_BindAddress_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Postal_BindAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).BindAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/BindAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).BindAddresses(ctx, req.(*BindAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Postal_ReleaseAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReleaseAddressRequest)
 	if err := dec(in); err != nil {
@@ -992,6 +1707,135 @@ func _Postal_ReleaseAddress_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Postal_RenewBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).RenewBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/RenewBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).RenewBinding(ctx, req.(*RenewBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Postal_AddressHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).AddressHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/AddressHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).AddressHistory(ctx, req.(*AddressHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Postal_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PostalServer).Watch(m, &postalWatchServer{stream})
+}
+
+type Postal_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type postalWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *postalWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Postal_RoleRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).RoleRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/RoleRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).RoleRange(ctx, req.(*RoleRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Postal_RoleSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).RoleSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/RoleSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).RoleSet(ctx, req.(*RoleSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Postal_RoleRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).RoleRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/RoleRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).RoleRemove(ctx, req.(*RoleRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Postal_AuditRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostalServer).AuditRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Postal/AuditRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostalServer).AuditRange(ctx, req.(*AuditRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Postal_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Postal",
 	HandlerType: (*PostalServer)(nil),
@@ -1040,12 +1884,46 @@ var _Postal_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BindAddress",
 			Handler:    _Postal_BindAddress_Handler,
 		},
+		{
+			MethodName: "BindAddresses",
+			Handler:    _Postal_BindAddresses_Handler,
+		},
 		{
 			MethodName: "ReleaseAddress",
 			Handler:    _Postal_ReleaseAddress_Handler,
 		},
+		{
+			MethodName: "RenewBinding",
+			Handler:    _Postal_RenewBinding_Handler,
+		},
+		{
+			MethodName: "AddressHistory",
+			Handler:    _Postal_AddressHistory_Handler,
+		},
+		{
+			MethodName: "RoleRange",
+			Handler:    _Postal_RoleRange_Handler,
+		},
+		{
+			MethodName: "RoleSet",
+			Handler:    _Postal_RoleSet_Handler,
+		},
+		{
+			MethodName: "RoleRemove",
+			Handler:    _Postal_RoleRemove_Handler,
+		},
+		{
+			MethodName: "AuditRange",
+			Handler:    _Postal_AuditRange_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Postal_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: fileDescriptorPostal,
 }
 
@@ -1190,6 +2068,16 @@ func (m *Pool) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.Type))
 	}
+	if m.Quarantine != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Quarantine))
+	}
+	if m.Strategy != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Strategy))
+	}
 	return i, nil
 }
 
@@ -1292,56 +2180,32 @@ func (m *Binding) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.ReleaseTime))
 	}
-	return i, nil
-}
-
-func (m *NetworkRangeRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if m.Ttl != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Ttl))
 	}
-	return data[:n], nil
-}
-
-func (m *NetworkRangeRequest) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		data[i] = 0xa
+	if m.QuarantineUntil != 0 {
+		data[i] = 0x48
 		i++
-		i = encodeVarintPostal(data, i, uint64(len(m.ID)))
-		i += copy(data[i:], m.ID)
+		i = encodeVarintPostal(data, i, uint64(m.QuarantineUntil))
 	}
-	if m.Size_ != 0 {
-		data[i] = 0x10
+	if len(m.AffinityKey) > 0 {
+		data[i] = 0x52
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.Size_))
+		i = encodeVarintPostal(data, i, uint64(len(m.AffinityKey)))
+		i += copy(data[i:], m.AffinityKey)
 	}
-	if len(m.Filters) > 0 {
-		for k, _ := range m.Filters {
-			data[i] = 0x1a
-			i++
-			v := m.Filters[k]
-			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			i = encodeVarintPostal(data, i, uint64(mapSize))
-			data[i] = 0xa
-			i++
-			i = encodeVarintPostal(data, i, uint64(len(k)))
-			i += copy(data[i:], k)
-			data[i] = 0x12
-			i++
-			i = encodeVarintPostal(data, i, uint64(len(v)))
-			i += copy(data[i:], v)
-		}
+	if len(m.Owner) > 0 {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Owner)))
+		i += copy(data[i:], m.Owner)
 	}
 	return i, nil
 }
 
-func (m *NetworkRangeResponse) Marshal() (data []byte, err error) {
+func (m *Event) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1351,32 +2215,50 @@ func (m *NetworkRangeResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *NetworkRangeResponse) MarshalTo(data []byte) (int, error) {
+func (m *Event) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Networks) > 0 {
-		for _, msg := range m.Networks {
-			data[i] = 0xa
-			i++
-			i = encodeVarintPostal(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Size_ != 0 {
-		data[i] = 0x10
+	if m.Type != 0 {
+		data[i] = 0x8
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.Size_))
+		i = encodeVarintPostal(data, i, uint64(m.Type))
+	}
+	if m.Network != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Network.Size()))
+		n3, err := m.Network.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Pool != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Pool.Size()))
+		n4, err := m.Pool.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Binding != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Binding.Size()))
+		n5, err := m.Binding.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
 	return i, nil
 }
 
-func (m *NetworkAddRequest) Marshal() (data []byte, err error) {
+func (m *Role) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1386,38 +2268,63 @@ func (m *NetworkAddRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *NetworkAddRequest) MarshalTo(data []byte) (int, error) {
+func (m *Role) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		for k, _ := range m.Annotations {
-			data[i] = 0xa
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
+	}
+	if len(m.Users) > 0 {
+		for _, s := range m.Users {
+			data[i] = 0x12
 			i++
-			v := m.Annotations[k]
-			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			i = encodeVarintPostal(data, i, uint64(mapSize))
-			data[i] = 0xa
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
 			i++
-			i = encodeVarintPostal(data, i, uint64(len(k)))
-			i += copy(data[i:], k)
-			data[i] = 0x12
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			data[i] = 0x1a
 			i++
-			i = encodeVarintPostal(data, i, uint64(len(v)))
-			i += copy(data[i:], v)
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
 		}
 	}
-	if len(m.Cidr) > 0 {
-		data[i] = 0x12
-		i++
-		i = encodeVarintPostal(data, i, uint64(len(m.Cidr)))
-		i += copy(data[i:], m.Cidr)
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			data[i] = 0x22
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *NetworkAddResponse) Marshal() (data []byte, err error) {
+func (m *Role_Rule) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1427,25 +2334,60 @@ func (m *NetworkAddResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *NetworkAddResponse) MarshalTo(data []byte) (int, error) {
+func (m *Role_Rule) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Network != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintPostal(data, i, uint64(m.Network.Size()))
-		n3, err := m.Network.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Networks) > 0 {
+		for _, s := range m.Networks {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
 		}
-		i += n3
 	}
 	return i, nil
 }
 
-func (m *NetworkRemoveRequest) Marshal() (data []byte, err error) {
+func (m *AuditEntry) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1455,7 +2397,7 @@ func (m *NetworkRemoveRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *NetworkRemoveRequest) MarshalTo(data []byte) (int, error) {
+func (m *AuditEntry) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1466,28 +2408,102 @@ func (m *NetworkRemoveRequest) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintPostal(data, i, uint64(len(m.ID)))
 		i += copy(data[i:], m.ID)
 	}
-	return i, nil
-}
-
-func (m *NetworkRemoveResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if m.Time != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Time))
+	}
+	if len(m.Method) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Method)))
+		i += copy(data[i:], m.Method)
+	}
+	if len(m.Identity) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Identity)))
+		i += copy(data[i:], m.Identity)
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			data[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Request) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Request)))
+		i += copy(data[i:], m.Request)
+	}
+	if len(m.Code) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Code)))
+		i += copy(data[i:], m.Code)
+	}
+	if len(m.Error) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Error)))
+		i += copy(data[i:], m.Error)
+	}
+	if m.Revision != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Revision))
+	}
+	if len(m.NetworkID) > 0 {
+		data[i] = 0x52
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.NetworkID)))
+		i += copy(data[i:], m.NetworkID)
+	}
+	if len(m.PoolID) > 0 {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.PoolID)))
+		i += copy(data[i:], m.PoolID)
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			data[i] = 0x62
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.Replayed {
+		data[i] = 0x68
+		i++
+		if m.Replayed {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	return data[:n], nil
-}
-
-func (m *NetworkRemoveResponse) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
 	return i, nil
 }
 
-func (m *PoolRangeRequest) Marshal() (data []byte, err error) {
+func (m *NetworkRangeRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1497,20 +2513,16 @@ func (m *PoolRangeRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *PoolRangeRequest) MarshalTo(data []byte) (int, error) {
+func (m *NetworkRangeRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ID != nil {
+	if len(m.ID) > 0 {
 		data[i] = 0xa
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.ID.Size()))
-		n4, err := m.ID.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+		i = encodeVarintPostal(data, i, uint64(len(m.ID)))
+		i += copy(data[i:], m.ID)
 	}
 	if m.Size_ != 0 {
 		data[i] = 0x10
@@ -1534,10 +2546,22 @@ func (m *PoolRangeRequest) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], v)
 		}
 	}
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
+	}
+	if len(m.Selector) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Selector)))
+		i += copy(data[i:], m.Selector)
+	}
 	return i, nil
 }
 
-func (m *PoolRangeResponse) Marshal() (data []byte, err error) {
+func (m *NetworkRangeResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1547,13 +2571,13 @@ func (m *PoolRangeResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *PoolRangeResponse) MarshalTo(data []byte) (int, error) {
+func (m *NetworkRangeResponse) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, msg := range m.Pools {
+	if len(m.Networks) > 0 {
+		for _, msg := range m.Networks {
 			data[i] = 0xa
 			i++
 			i = encodeVarintPostal(data, i, uint64(msg.Size()))
@@ -1569,10 +2593,16 @@ func (m *PoolRangeResponse) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.Size_))
 	}
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
+	}
 	return i, nil
 }
 
-func (m *PoolAddRequest) Marshal() (data []byte, err error) {
+func (m *NetworkAddRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1582,20 +2612,14 @@ func (m *PoolAddRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *PoolAddRequest) MarshalTo(data []byte) (int, error) {
+func (m *NetworkAddRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetworkID) > 0 {
-		data[i] = 0xa
-		i++
-		i = encodeVarintPostal(data, i, uint64(len(m.NetworkID)))
-		i += copy(data[i:], m.NetworkID)
-	}
 	if len(m.Annotations) > 0 {
 		for k, _ := range m.Annotations {
-			data[i] = 0x12
+			data[i] = 0xa
 			i++
 			v := m.Annotations[k]
 			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
@@ -1610,20 +2634,16 @@ func (m *PoolAddRequest) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], v)
 		}
 	}
-	if m.Maximum != 0 {
-		data[i] = 0x18
-		i++
-		i = encodeVarintPostal(data, i, uint64(m.Maximum))
-	}
-	if m.Type != 0 {
-		data[i] = 0x20
+	if len(m.Cidr) > 0 {
+		data[i] = 0x12
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.Type))
+		i = encodeVarintPostal(data, i, uint64(len(m.Cidr)))
+		i += copy(data[i:], m.Cidr)
 	}
 	return i, nil
 }
 
-func (m *PoolAddResponse) Marshal() (data []byte, err error) {
+func (m *NetworkAddResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1633,25 +2653,25 @@ func (m *PoolAddResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *PoolAddResponse) MarshalTo(data []byte) (int, error) {
+func (m *NetworkAddResponse) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pool != nil {
+	if m.Network != nil {
 		data[i] = 0xa
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.Pool.Size()))
-		n5, err := m.Pool.MarshalTo(data[i:])
+		i = encodeVarintPostal(data, i, uint64(m.Network.Size()))
+		n6, err := m.Network.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	return i, nil
 }
 
-func (m *PoolRemoveRequest) Marshal() (data []byte, err error) {
+func (m *NetworkRemoveRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1661,25 +2681,31 @@ func (m *PoolRemoveRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *PoolRemoveRequest) MarshalTo(data []byte) (int, error) {
+func (m *NetworkRemoveRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ID != nil {
+	if len(m.ID) > 0 {
 		data[i] = 0xa
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.ID.Size()))
-		n6, err := m.ID.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintPostal(data, i, uint64(len(m.ID)))
+		i += copy(data[i:], m.ID)
+	}
+	if m.Force {
+		data[i] = 0x10
+		i++
+		if m.Force {
+			data[i] = 1
+		} else {
+			data[i] = 0
 		}
-		i += n6
+		i++
 	}
 	return i, nil
 }
 
-func (m *PoolRemoveResponse) Marshal() (data []byte, err error) {
+func (m *NetworkRemoveResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1689,7 +2715,7 @@ func (m *PoolRemoveResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *PoolRemoveResponse) MarshalTo(data []byte) (int, error) {
+func (m *NetworkRemoveResponse) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1697,7 +2723,7 @@ func (m *PoolRemoveResponse) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PoolSetMaxRequest) Marshal() (data []byte, err error) {
+func (m *PoolRangeRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1707,27 +2733,275 @@ func (m *PoolSetMaxRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *PoolSetMaxRequest) MarshalTo(data []byte) (int, error) {
+func (m *PoolRangeRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.PoolID != nil {
+	if m.ID != nil {
 		data[i] = 0xa
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
-		n7, err := m.PoolID.MarshalTo(data[i:])
+		i = encodeVarintPostal(data, i, uint64(m.ID.Size()))
+		n7, err := m.ID.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n7
 	}
-	if m.Maximum != 0 {
+	if m.Size_ != 0 {
 		data[i] = 0x10
 		i++
-		i = encodeVarintPostal(data, i, uint64(m.Maximum))
+		i = encodeVarintPostal(data, i, uint64(m.Size_))
 	}
-	return i, nil
+	if len(m.Filters) > 0 {
+		for k, _ := range m.Filters {
+			data[i] = 0x1a
+			i++
+			v := m.Filters[k]
+			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			i = encodeVarintPostal(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
+	}
+	if len(m.Selector) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Selector)))
+		i += copy(data[i:], m.Selector)
+	}
+	return i, nil
+}
+
+func (m *PoolRangeResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PoolRangeResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, msg := range m.Pools {
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Size_ != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Size_))
+	}
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
+	}
+	return i, nil
+}
+
+func (m *PoolAddRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PoolAddRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkID) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.NetworkID)))
+		i += copy(data[i:], m.NetworkID)
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			data[i] = 0x12
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			i = encodeVarintPostal(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if m.Maximum != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Maximum))
+	}
+	if m.Type != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Type))
+	}
+	if m.Quarantine != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Quarantine))
+	}
+	if m.Strategy != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Strategy))
+	}
+	return i, nil
+}
+
+func (m *PoolAddResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PoolAddResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Pool.Size()))
+		n8, err := m.Pool.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+
+func (m *PoolRemoveRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PoolRemoveRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.ID.Size()))
+		n9, err := m.ID.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Force {
+		data[i] = 0x10
+		i++
+		if m.Force {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PoolRemoveResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PoolRemoveResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *PoolSetMaxRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PoolSetMaxRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
+		n10, err := m.PoolID.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Maximum != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Maximum))
+	}
+	return i, nil
 }
 
 func (m *PoolSetMaxResponse) Marshal() (data []byte, err error) {
@@ -1791,6 +3065,18 @@ func (m *BindingRangeRequest) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], v)
 		}
 	}
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
+	}
+	if len(m.Selector) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Selector)))
+		i += copy(data[i:], m.Selector)
+	}
 	return i, nil
 }
 
@@ -1826,6 +3112,12 @@ func (m *BindingRangeResponse) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.Size_))
 	}
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
+	}
 	return i, nil
 }
 
@@ -1848,11 +3140,11 @@ func (m *AllocateAddressRequest) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
-		n8, err := m.PoolID.MarshalTo(data[i:])
+		n11, err := m.PoolID.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	if len(m.Address) > 0 {
 		data[i] = 0x12
@@ -1860,6 +3152,12 @@ func (m *AllocateAddressRequest) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintPostal(data, i, uint64(len(m.Address)))
 		i += copy(data[i:], m.Address)
 	}
+	if len(m.IdempotencyKey) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.IdempotencyKey)))
+		i += copy(data[i:], m.IdempotencyKey)
+	}
 	return i, nil
 }
 
@@ -1882,11 +3180,11 @@ func (m *AllocateAddressResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.Binding.Size()))
-		n9, err := m.Binding.MarshalTo(data[i:])
+		n12, err := m.Binding.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n12
 	}
 	return i, nil
 }
@@ -1910,11 +3208,11 @@ func (m *BulkAllocateAddressRequest) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
-		n10, err := m.PoolID.MarshalTo(data[i:])
+		n13, err := m.PoolID.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n13
 	}
 	if len(m.Cidr) > 0 {
 		data[i] = 0x12
@@ -1922,6 +3220,12 @@ func (m *BulkAllocateAddressRequest) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintPostal(data, i, uint64(len(m.Cidr)))
 		i += copy(data[i:], m.Cidr)
 	}
+	if len(m.IdempotencyKey) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.IdempotencyKey)))
+		i += copy(data[i:], m.IdempotencyKey)
+	}
 	return i, nil
 }
 
@@ -1970,11 +3274,11 @@ func (m *BulkAllocateAddressResponse) MarshalTo(data []byte) (int, error) {
 			data[i] = 0x12
 			i++
 			i = encodeVarintPostal(data, i, uint64(v.Size()))
-			n11, err := v.MarshalTo(data[i:])
+			n14, err := v.MarshalTo(data[i:])
 			if err != nil {
 				return 0, err
 			}
-			i += n11
+			i += n14
 		}
 	}
 	return i, nil
@@ -1999,11 +3303,11 @@ func (m *BindAddressRequest) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
-		n12, err := m.PoolID.MarshalTo(data[i:])
+		n15, err := m.PoolID.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n15
 	}
 	if len(m.Address) > 0 {
 		data[i] = 0x12
@@ -2028,11 +3332,44 @@ func (m *BindAddressRequest) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], v)
 		}
 	}
-	return i, nil
-}
-
-func (m *BindAddressResponse) Marshal() (data []byte, err error) {
-	size := m.Size()
+	if m.Ttl != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Ttl))
+	}
+	if m.IgnoreQuarantine {
+		data[i] = 0x28
+		i++
+		if m.IgnoreQuarantine {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.AffinityKey) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.AffinityKey)))
+		i += copy(data[i:], m.AffinityKey)
+	}
+	if len(m.Owner) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Owner)))
+		i += copy(data[i:], m.Owner)
+	}
+	if len(m.IdempotencyKey) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.IdempotencyKey)))
+		i += copy(data[i:], m.IdempotencyKey)
+	}
+	return i, nil
+}
+
+func (m *BindAddressResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
 	if err != nil {
@@ -2050,11 +3387,194 @@ func (m *BindAddressResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.Binding.Size()))
-		n13, err := m.Binding.MarshalTo(data[i:])
+		n16, err := m.Binding.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n16
+	}
+	return i, nil
+}
+
+func (m *BindAddressesRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BindAddressesRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
+		n17, err := m.PoolID.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.Count != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Count))
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			data[i] = 0x1a
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			i = encodeVarintPostal(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if len(m.BindingAnnotations) > 0 {
+		for _, msg := range m.BindingAnnotations {
+			data[i] = 0x22
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Ttl != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Ttl))
+	}
+	if m.Atomic {
+		data[i] = 0x30
+		i++
+		if m.Atomic {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.IgnoreQuarantine {
+		data[i] = 0x38
+		i++
+		if m.IgnoreQuarantine {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.IdempotencyKey) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.IdempotencyKey)))
+		i += copy(data[i:], m.IdempotencyKey)
+	}
+	return i, nil
+}
+
+func (m *BindAddressesRequest_Annotations) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BindAddressesRequest_Annotations) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			data[i] = 0xa
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			i = encodeVarintPostal(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *BindAddressesResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BindAddressesResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, msg := range m.Bindings {
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Errors) > 0 {
+		for k, _ := range m.Errors {
+			data[i] = 0x12
+			i++
+			v := m.Errors[k]
+			if v == nil {
+				return 0, errors.New("proto: map has nil element")
+			}
+			msgSize := v.Size()
+			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + msgSize + sovPostal(uint64(msgSize))
+			i = encodeVarintPostal(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintPostal(data, i, uint64(v.Size()))
+			n18, err := v.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n18
+		}
 	}
 	return i, nil
 }
@@ -2078,11 +3598,11 @@ func (m *ReleaseAddressRequest) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
-		n14, err := m.PoolID.MarshalTo(data[i:])
+		n19, err := m.PoolID.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n19
 	}
 	if len(m.BindingID) > 0 {
 		data[i] = 0x12
@@ -2106,6 +3626,12 @@ func (m *ReleaseAddressRequest) MarshalTo(data []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.IdempotencyKey) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.IdempotencyKey)))
+		i += copy(data[i:], m.IdempotencyKey)
+	}
 	return i, nil
 }
 
@@ -2124,438 +3650,623 @@ func (m *ReleaseAddressResponse) MarshalTo(data []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Binding != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Binding.Size()))
+		n20, err := m.Binding.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
 	return i, nil
 }
 
-func encodeFixed64Postal(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Postal(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintPostal(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RenewBindingRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	data[offset] = uint8(v)
-	return offset + 1
+	return data[:n], nil
 }
-func (m *Error) Size() (n int) {
+
+func (m *RenewBindingRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
+	if m.PoolID != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.PoolID.Size()))
+		n21, err := m.PoolID.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
-	return n
+	if len(m.BindingID) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.BindingID)))
+		i += copy(data[i:], m.BindingID)
+	}
+	return i, nil
 }
 
-func (m *Empty) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *RenewBindingResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *Network) Size() (n int) {
+func (m *RenewBindingResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+	if m.Binding != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Binding.Size()))
+		n22, err := m.Binding.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n22
 	}
-	l = len(m.Cidr)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
+	return i, nil
+}
+
+func (m *AddressHistoryRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *Pool) Size() (n int) {
+func (m *AddressHistoryRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != nil {
-		l = m.ID.Size()
-		n += 1 + l + sovPostal(uint64(l))
+	if len(m.NetworkID) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.NetworkID)))
+		i += copy(data[i:], m.NetworkID)
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
-		}
+	if len(m.Address) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Address)))
+		i += copy(data[i:], m.Address)
 	}
-	if m.MaximumAddresses != 0 {
-		n += 1 + sovPostal(uint64(m.MaximumAddresses))
+	if m.Size_ != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Size_))
 	}
-	if m.Type != 0 {
-		n += 1 + sovPostal(uint64(m.Type))
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Pool_PoolID) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.NetworkID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
+func (m *AddressHistoryResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *Binding) Size() (n int) {
+func (m *AddressHistoryResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != nil {
-		l = m.PoolID.Size()
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+	if len(m.Bindings) > 0 {
+		for _, msg := range m.Bindings {
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	if m.AllocateTime != 0 {
-		n += 1 + sovPostal(uint64(m.AllocateTime))
+	if m.Size_ != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Size_))
 	}
-	if m.BindTime != 0 {
-		n += 1 + sovPostal(uint64(m.BindTime))
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
 	}
-	if m.ReleaseTime != 0 {
-		n += 1 + sovPostal(uint64(m.ReleaseTime))
+	return i, nil
+}
+
+func (m *WatchRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *NetworkRangeRequest) Size() (n int) {
+func (m *WatchRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovPostal(uint64(m.Size_))
-	}
 	if len(m.Filters) > 0 {
-		for k, v := range m.Filters {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		for k, _ := range m.Filters {
+			data[i] = 0xa
+			i++
+			v := m.Filters[k]
+			mapSize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			i = encodeVarintPostal(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintPostal(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
 		}
 	}
-	return n
+	if m.Revision != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Revision))
+	}
+	if len(m.Selector) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Selector)))
+		i += copy(data[i:], m.Selector)
+	}
+	return i, nil
 }
 
-func (m *NetworkRangeResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Networks) > 0 {
-		for _, e := range m.Networks {
-			l = e.Size()
-			n += 1 + l + sovPostal(uint64(l))
-		}
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovPostal(uint64(m.Size_))
+func (m *WatchResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *NetworkAddRequest) Size() (n int) {
+func (m *WatchResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.Cidr)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
+	if m.Revision != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Revision))
 	}
-	return n
+	return i, nil
 }
 
-func (m *NetworkAddResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Network != nil {
-		l = m.Network.Size()
-		n += 1 + l + sovPostal(uint64(l))
+func (m *RoleRangeRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *NetworkRemoveRequest) Size() (n int) {
+func (m *RoleRangeRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
 	}
-	return n
+	return i, nil
 }
 
-func (m *NetworkRemoveResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *RoleRangeResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *PoolRangeRequest) Size() (n int) {
+func (m *RoleRangeResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != nil {
-		l = m.ID.Size()
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovPostal(uint64(m.Size_))
-	}
-	if len(m.Filters) > 0 {
-		for k, v := range m.Filters {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+	if len(m.Roles) > 0 {
+		for _, msg := range m.Roles {
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *PoolRangeResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovPostal(uint64(l))
-		}
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovPostal(uint64(m.Size_))
+func (m *RoleSetRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *PoolAddRequest) Size() (n int) {
+func (m *RoleSetRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NetworkID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+	if m.Role != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Role.Size()))
+		n23, err := m.Role.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n23
 	}
-	if m.Maximum != 0 {
-		n += 1 + sovPostal(uint64(m.Maximum))
-	}
-	if m.Type != 0 {
-		n += 1 + sovPostal(uint64(m.Type))
-	}
-	return n
+	return i, nil
 }
 
-func (m *PoolAddResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovPostal(uint64(l))
+func (m *RoleSetResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *PoolRemoveRequest) Size() (n int) {
+func (m *RoleSetResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != nil {
-		l = m.ID.Size()
-		n += 1 + l + sovPostal(uint64(l))
+	if m.Role != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Role.Size()))
+		n24, err := m.Role.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
 	}
-	return n
+	return i, nil
 }
 
-func (m *PoolRemoveResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *RoleRemoveRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *PoolSetMaxRequest) Size() (n int) {
+func (m *RoleRemoveRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != nil {
-		l = m.PoolID.Size()
-		n += 1 + l + sovPostal(uint64(l))
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
 	}
-	if m.Maximum != 0 {
-		n += 1 + sovPostal(uint64(m.Maximum))
+	return i, nil
+}
+
+func (m *RoleRemoveResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *PoolSetMaxResponse) Size() (n int) {
+func (m *RoleRemoveResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	return i, nil
 }
 
-func (m *BindingRangeRequest) Size() (n int) {
+func (m *AuditRangeRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *AuditRangeRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NetworkID)
-	if l > 0 {
-		n += 1 + l + sovPostal(uint64(l))
+	if m.Start != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Start))
+	}
+	if m.End != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.End))
+	}
+	if len(m.NetworkID) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.NetworkID)))
+		i += copy(data[i:], m.NetworkID)
+	}
+	if len(m.PoolID) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.PoolID)))
+		i += copy(data[i:], m.PoolID)
+	}
+	if len(m.Address) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Address)))
+		i += copy(data[i:], m.Address)
+	}
+	if len(m.Identity) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Identity)))
+		i += copy(data[i:], m.Identity)
+	}
+	if len(m.Method) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.Method)))
+		i += copy(data[i:], m.Method)
 	}
 	if m.Size_ != 0 {
-		n += 1 + sovPostal(uint64(m.Size_))
+		data[i] = 0x40
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Size_))
 	}
-	if len(m.Filters) > 0 {
-		for k, v := range m.Filters {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
-		}
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BindingRangeResponse) Size() (n int) {
+func (m *AuditRangeResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *AuditRangeResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Bindings) > 0 {
-		for _, e := range m.Bindings {
-			l = e.Size()
-			n += 1 + l + sovPostal(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			data[i] = 0xa
+			i++
+			i = encodeVarintPostal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.Size_ != 0 {
-		n += 1 + sovPostal(uint64(m.Size_))
+		data[i] = 0x10
+		i++
+		i = encodeVarintPostal(data, i, uint64(m.Size_))
 	}
-	return n
+	if len(m.ContinuationToken) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPostal(data, i, uint64(len(m.ContinuationToken)))
+		i += copy(data[i:], m.ContinuationToken)
+	}
+	return i, nil
 }
 
-func (m *AllocateAddressRequest) Size() (n int) {
+func encodeFixed64Postal(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Postal(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPostal(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Error) Size() (n int) {
 	var l int
 	_ = l
-	if m.PoolID != nil {
-		l = m.PoolID.Size()
-		n += 1 + l + sovPostal(uint64(l))
-	}
-	l = len(m.Address)
+	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovPostal(uint64(l))
 	}
 	return n
 }
 
-func (m *AllocateAddressResponse) Size() (n int) {
+func (m *Empty) Size() (n int) {
 	var l int
 	_ = l
-	if m.Binding != nil {
-		l = m.Binding.Size()
-		n += 1 + l + sovPostal(uint64(l))
-	}
 	return n
 }
 
-func (m *BulkAllocateAddressRequest) Size() (n int) {
+func (m *Network) Size() (n int) {
 	var l int
 	_ = l
-	if m.PoolID != nil {
-		l = m.PoolID.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPostal(uint64(l))
 	}
-	l = len(m.Cidr)
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Cidr)
 	if l > 0 {
 		n += 1 + l + sovPostal(uint64(l))
 	}
 	return n
 }
 
-func (m *BulkAllocateAddressResponse) Size() (n int) {
+func (m *Pool) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Bindings) > 0 {
-		for _, e := range m.Bindings {
-			l = e.Size()
-			n += 1 + l + sovPostal(uint64(l))
-		}
+	if m.ID != nil {
+		l = m.ID.Size()
+		n += 1 + l + sovPostal(uint64(l))
 	}
-	if len(m.Errors) > 0 {
-		for k, v := range m.Errors {
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
 			_ = k
 			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-			}
-			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + l + sovPostal(uint64(l))
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
 			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
 		}
 	}
+	if m.MaximumAddresses != 0 {
+		n += 1 + sovPostal(uint64(m.MaximumAddresses))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPostal(uint64(m.Type))
+	}
+	if m.Quarantine != 0 {
+		n += 1 + sovPostal(uint64(m.Quarantine))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovPostal(uint64(m.Strategy))
+	}
 	return n
 }
 
-func (m *BindAddressRequest) Size() (n int) {
+func (m *Pool_PoolID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *Binding) Size() (n int) {
 	var l int
 	_ = l
 	if m.PoolID != nil {
 		l = m.PoolID.Size()
 		n += 1 + l + sovPostal(uint64(l))
 	}
-	l = len(m.Address)
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovPostal(uint64(l))
 	}
@@ -2567,12 +4278,50 @@ func (m *BindAddressRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.AllocateTime != 0 {
+		n += 1 + sovPostal(uint64(m.AllocateTime))
+	}
+	if m.BindTime != 0 {
+		n += 1 + sovPostal(uint64(m.BindTime))
+	}
+	if m.ReleaseTime != 0 {
+		n += 1 + sovPostal(uint64(m.ReleaseTime))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovPostal(uint64(m.Ttl))
+	}
+	if m.QuarantineUntil != 0 {
+		n += 1 + sovPostal(uint64(m.QuarantineUntil))
+	}
+	l = len(m.AffinityKey)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
 	return n
 }
 
-func (m *BindAddressResponse) Size() (n int) {
+func (m *Event) Size() (n int) {
 	var l int
 	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPostal(uint64(m.Type))
+	}
+	if m.Network != nil {
+		l = m.Network.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
 	if m.Binding != nil {
 		l = m.Binding.Size()
 		n += 1 + l + sovPostal(uint64(l))
@@ -2580,47 +4329,4571 @@ func (m *BindAddressResponse) Size() (n int) {
 	return n
 }
 
-func (m *ReleaseAddressRequest) Size() (n int) {
+func (m *Role) Size() (n int) {
 	var l int
 	_ = l
-	if m.PoolID != nil {
-		l = m.PoolID.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPostal(uint64(l))
 	}
-	l = len(m.BindingID)
+	if len(m.Users) > 0 {
+		for _, s := range m.Users {
+			l = len(s)
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Role_Rule) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if len(m.Networks) > 0 {
+		for _, s := range m.Networks {
+			l = len(s)
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuditEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovPostal(uint64(l))
 	}
-	l = len(m.Address)
+	if m.Time != 0 {
+		n += 1 + sovPostal(uint64(m.Time))
+	}
+	l = len(m.Method)
 	if l > 0 {
 		n += 1 + l + sovPostal(uint64(l))
 	}
-	if m.Hard {
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPostal(uint64(m.Revision))
+	}
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Replayed {
 		n += 2
 	}
 	return n
 }
 
-func (m *ReleaseAddressResponse) Size() (n int) {
+func (m *NetworkRangeRequest) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	if len(m.Filters) > 0 {
+		for k, v := range m.Filters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
 	return n
 }
 
-func sovPostal(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *NetworkRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkAddRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Cidr)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkAddResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Network != nil {
+		l = m.Network.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkRemoveRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *NetworkRemoveResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PoolRangeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != nil {
+		l = m.ID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	if len(m.Filters) > 0 {
+		for k, v := range m.Filters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolAddRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	if m.Maximum != 0 {
+		n += 1 + sovPostal(uint64(m.Maximum))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPostal(uint64(m.Type))
+	}
+	if m.Quarantine != 0 {
+		n += 1 + sovPostal(uint64(m.Quarantine))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovPostal(uint64(m.Strategy))
+	}
+	return n
+}
+
+func (m *PoolAddResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolRemoveRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != nil {
+		l = m.ID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *PoolRemoveResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PoolSetMaxRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		l = m.PoolID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Maximum != 0 {
+		n += 1 + sovPostal(uint64(m.Maximum))
+	}
+	return n
+}
+
+func (m *PoolSetMaxResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BindingRangeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	if len(m.Filters) > 0 {
+		for k, v := range m.Filters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *BindingRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *AllocateAddressRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		l = m.PoolID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *AllocateAddressResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Binding != nil {
+		l = m.Binding.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkAllocateAddressRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		l = m.PoolID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Cidr)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkAllocateAddressResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for k, v := range m.Errors {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+			}
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + l + sovPostal(uint64(l))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *BindAddressRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		l = m.PoolID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovPostal(uint64(m.Ttl))
+	}
+	if m.IgnoreQuarantine {
+		n += 2
+	}
+	l = len(m.AffinityKey)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *BindAddressResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Binding != nil {
+		l = m.Binding.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *BindAddressesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		l = m.PoolID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPostal(uint64(m.Count))
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BindingAnnotations) > 0 {
+		for _, e := range m.BindingAnnotations {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovPostal(uint64(m.Ttl))
+	}
+	if m.Atomic {
+		n += 2
+	}
+	if m.IgnoreQuarantine {
+		n += 2
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *BindAddressesRequest_Annotations) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *BindAddressesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for k, v := range m.Errors {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+			}
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + l + sovPostal(uint64(l))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseAddressRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		l = m.PoolID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.BindingID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Hard {
+		n += 2
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleaseAddressResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Binding != nil {
+		l = m.Binding.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *RenewBindingRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.PoolID != nil {
+		l = m.PoolID.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.BindingID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *RenewBindingResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Binding != nil {
+		l = m.Binding.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressHistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressHistoryResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for k, v := range m.Filters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPostal(uint64(len(k))) + 1 + len(v) + sovPostal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPostal(uint64(mapEntrySize))
+		}
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPostal(uint64(m.Revision))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPostal(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *RoleRangeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleSetRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = m.Role.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleSetResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = m.Role.Size()
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleRemoveRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleRemoveResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AuditRangeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovPostal(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovPostal(uint64(m.End))
+	}
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovPostal(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPostal(uint64(m.Size_))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovPostal(uint64(l))
+	}
+	return n
+}
+
+func sovPostal(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPostal(x uint64) (n int) {
+	return sovPostal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Error) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Empty) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Network) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Network: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Network: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cidr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ID == nil {
+				m.ID = &Pool_PoolID{}
+			}
+			if err := m.ID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumAddresses", wireType)
+			}
+			m.MaximumAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MaximumAddresses |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Type |= (Pool_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantine", wireType)
+			}
+			m.Quarantine = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Quarantine |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Strategy |= (Pool_Strategy(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool_PoolID) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Binding) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Binding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Binding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
+			}
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocateTime", wireType)
+			}
+			m.AllocateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.AllocateTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindTime", wireType)
+			}
+			m.BindTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BindTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			m.ReleaseTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ReleaseTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ttl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineUntil", wireType)
+			}
+			m.QuarantineUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.QuarantineUntil |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffinityKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffinityKey = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Type |= (Event_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Network == nil {
+				m.Network = &Network{}
+			}
+			if err := m.Network.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Binding == nil {
+				m.Binding = &Binding{}
+			}
+			if err := m.Binding.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Role) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &Role_Rule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Role_Rule) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Networks = append(m.Networks, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replayed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replayed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Size_ |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Filters == nil {
+				m.Filters = make(map[string]string)
+			}
+			m.Filters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuationToken = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkRangeResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Networks = append(m.Networks, &Network{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Size_ |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuationToken = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkAddRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkAddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cidr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkAddResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkAddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Network == nil {
+				m.Network = &Network{}
+			}
+			if err := m.Network.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkRemoveRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkRemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkRemoveResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkRemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRangeRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ID == nil {
+				m.ID = &Pool_PoolID{}
+			}
+			if err := m.ID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Size_ |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Filters == nil {
+				m.Filters = make(map[string]string)
+			}
+			m.Filters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuationToken = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRangeResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Size_ |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuationToken = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAddRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
+			}
+			m.Maximum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Maximum |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Type |= (Pool_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantine", wireType)
+			}
+			m.Quarantine = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Quarantine |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Strategy |= (Pool_Strategy(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAddResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRemoveRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ID == nil {
+				m.ID = &Pool_PoolID{}
+			}
+			if err := m.ID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRemoveResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozPostal(x uint64) (n int) {
-	return sovPostal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Error) Unmarshal(data []byte) error {
+func (m *PoolSetMaxRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,17 +8916,17 @@ func (m *Error) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSetMaxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSetMaxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -2663,21 +8936,44 @@ func (m *Error) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(data[iNdEx:postIndex])
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
+			}
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
+			}
+			m.Maximum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Maximum |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -2699,7 +8995,7 @@ func (m *Error) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Empty) Unmarshal(data []byte) error {
+func (m *PoolSetMaxResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2722,10 +9018,10 @@ func (m *Empty) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSetMaxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSetMaxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2749,7 +9045,7 @@ func (m *Empty) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Network) Unmarshal(data []byte) error {
+func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2772,15 +9068,15 @@ func (m *Network) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Network: wiretype end group for non-group")
+			return fmt.Errorf("proto: BindingRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Network: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BindingRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2805,11 +9101,30 @@ func (m *Network) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(data[iNdEx:postIndex])
+			m.NetworkID = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Size_ |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2888,7 +9203,180 @@ func (m *Network) Unmarshal(data []byte) error {
 					break
 				}
 			}
-			var stringLenmapvalue uint64
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Filters == nil {
+				m.Filters = make(map[string]string)
+			}
+			m.Filters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuationToken = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BindingRangeResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BindingRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BindingRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, &Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -2898,29 +9386,14 @@ func (m *Network) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				m.Size_ |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
-				return io.ErrUnexpectedEOF
-			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2945,7 +9418,7 @@ func (m *Network) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cidr = string(data[iNdEx:postIndex])
+			m.ContinuationToken = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2968,7 +9441,7 @@ func (m *Network) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(data []byte) error {
+func (m *AllocateAddressRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2991,15 +9464,15 @@ func (m *Pool) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocateAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocateAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3023,18 +9496,18 @@ func (m *Pool) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ID == nil {
-				m.ID = &Pool_PoolID{}
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
 			}
-			if err := m.ID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3044,34 +9517,26 @@ func (m *Pool) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
-			var stringLenmapkey uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3081,90 +9546,76 @@ func (m *Pool) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			var valuekey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				valuekey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var stringLenmapvalue uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.IdempotencyKey = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocateAddressResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumAddresses", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaximumAddresses = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.MaximumAddresses |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocateAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocateAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3174,11 +9625,25 @@ func (m *Pool) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Type |= (Pool_Type(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Binding == nil {
+				m.Binding = &Binding{}
+			}
+			if err := m.Binding.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -3200,7 +9665,7 @@ func (m *Pool) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Pool_PoolID) Unmarshal(data []byte) error {
+func (m *BulkAllocateAddressRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3223,15 +9688,48 @@ func (m *Pool_PoolID) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolID: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkAllocateAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkAllocateAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
+			}
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3256,11 +9754,11 @@ func (m *Pool_PoolID) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkID = string(data[iNdEx:postIndex])
+			m.Cidr = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3285,7 +9783,7 @@ func (m *Pool_PoolID) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(data[iNdEx:postIndex])
+			m.IdempotencyKey = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3308,7 +9806,7 @@ func (m *Pool_PoolID) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Binding) Unmarshal(data []byte) error {
+func (m *BulkAllocateAddressResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3331,15 +9829,15 @@ func (m *Binding) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Binding: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkAllocateAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Binding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkAllocateAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3363,45 +9861,14 @@ func (m *Binding) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolID == nil {
-				m.PoolID = &Pool_PoolID{}
-			}
-			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Bindings = append(m.Bindings, &Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3480,7 +9947,7 @@ func (m *Binding) Unmarshal(data []byte) error {
 					break
 				}
 			}
-			var stringLenmapvalue uint64
+			var mapmsglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3490,27 +9957,115 @@ func (m *Binding) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				mapmsglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
+			if mapmsglen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
+			postmsgIndex := iNdEx + mapmsglen
+			if mapmsglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if postmsgIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			mapvalue := &Error{}
+			if err := mapvalue.Unmarshal(data[iNdEx:postmsgIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
+			iNdEx = postmsgIndex
+			if m.Errors == nil {
+				m.Errors = make(map[string]*Error)
+			}
+			m.Errors[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BindAddressRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BindAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BindAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
+			}
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -3539,11 +10094,11 @@ func (m *Binding) Unmarshal(data []byte) error {
 			}
 			m.Address = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocateTime", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			m.AllocateTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3553,16 +10108,19 @@ func (m *Binding) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.AllocateTime |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BindTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
 			}
-			m.BindTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3572,16 +10130,12 @@ func (m *Binding) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.BindTime |= (int64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
-			}
-			m.ReleaseTime = 0
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3591,66 +10145,37 @@ func (m *Binding) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ReleaseTime |= (int64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPostal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthPostal
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
-			}
-			if iNdEx >= l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkRangeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			var stringLenmapvalue uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3660,26 +10185,31 @@ func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(data[iNdEx:postIndex])
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			m.Size_ = 0
+			m.Ttl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3689,16 +10219,16 @@ func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Size_ |= (int32(b) & 0x7F) << shift
+				m.Ttl |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreQuarantine", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3708,19 +10238,17 @@ func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.IgnoreQuarantine = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffinityKey", wireType)
 			}
-			var keykey uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3730,12 +10258,26 @@ func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffinityKey = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3745,37 +10287,26 @@ func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			var valuekey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				valuekey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Owner = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
-			var stringLenmapvalue uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3785,25 +10316,20 @@ func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Filters == nil {
-				m.Filters = make(map[string]string)
-			}
-			m.Filters[mapkey] = mapvalue
+			m.IdempotencyKey = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3826,7 +10352,7 @@ func (m *NetworkRangeRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *NetworkRangeResponse) Unmarshal(data []byte) error {
+func (m *BindAddressResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3849,15 +10375,15 @@ func (m *NetworkRangeResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BindAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BindAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3881,30 +10407,13 @@ func (m *NetworkRangeResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Networks = append(m.Networks, &Network{})
-			if err := m.Networks[len(m.Networks)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if m.Binding == nil {
+				m.Binding = &Binding{}
+			}
+			if err := m.Binding.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Size_ |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -3926,7 +10435,7 @@ func (m *NetworkRangeResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *NetworkAddRequest) Unmarshal(data []byte) error {
+func (m *BindAddressesRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3949,15 +10458,15 @@ func (m *NetworkAddRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkAddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BindAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BindAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3981,7 +10490,18 @@ func (m *NetworkAddRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
+			}
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -3991,12 +10511,16 @@ func (m *NetworkAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.Count |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4006,22 +10530,19 @@ func (m *NetworkAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			var valuekey uint64
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4031,12 +10552,12 @@ func (m *NetworkAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				valuekey |= (uint64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapvalue uint64
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4046,31 +10567,22 @@ func (m *NetworkAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
-			}
-			var stringLen uint64
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4080,74 +10592,44 @@ func (m *NetworkAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				valuekey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cidr = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPostal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPostal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetworkAddResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkAddResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4171,66 +10653,73 @@ func (m *NetworkAddResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Network == nil {
-				m.Network = &Network{}
-			}
-			if err := m.Network.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.BindingAnnotations = append(m.BindingAnnotations, &BindAddressesRequest_Annotations{})
+			if err := m.BindingAnnotations[len(m.BindingAnnotations)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPostal(data[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPostal
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ttl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetworkRemoveRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Atomic = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreQuarantine", wireType)
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkRemoveRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IgnoreQuarantine = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4255,7 +10744,7 @@ func (m *NetworkRemoveRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(data[iNdEx:postIndex])
+			m.IdempotencyKey = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4278,140 +10767,38 @@ func (m *NetworkRemoveRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *NetworkRemoveResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkRemoveResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPostal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPostal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolRangeRequest) Unmarshal(data []byte) error {
+func (m *BindAddressesRequest_Annotations) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRangeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ID == nil {
-				m.ID = &Pool_PoolID{}
-			}
-			if err := m.ID.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Size_ |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
 			}
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Annotations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Annotations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4515,10 +10902,10 @@ func (m *PoolRangeRequest) Unmarshal(data []byte) error {
 			}
 			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
 			iNdEx = postStringIndexmapvalue
-			if m.Filters == nil {
-				m.Filters = make(map[string]string)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			m.Filters[mapkey] = mapvalue
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4541,7 +10928,7 @@ func (m *PoolRangeRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *PoolRangeResponse) Unmarshal(data []byte) error {
+func (m *BindAddressesResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4564,15 +10951,15 @@ func (m *PoolRangeResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BindAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BindAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4596,16 +10983,16 @@ func (m *PoolRangeResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Bindings = append(m.Bindings, &Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			m.Size_ = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4615,11 +11002,108 @@ func (m *PoolRangeResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Size_ |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapmsglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				mapmsglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if mapmsglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postmsgIndex := iNdEx + mapmsglen
+			if mapmsglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if postmsgIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := &Error{}
+			if err := mapvalue.Unmarshal(data[iNdEx:postmsgIndex]); err != nil {
+				return err
+			}
+			iNdEx = postmsgIndex
+			if m.Errors == nil {
+				m.Errors = make(map[string]*Error)
+			}
+			m.Errors[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -4641,7 +11125,7 @@ func (m *PoolRangeResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *PoolAddRequest) Unmarshal(data []byte) error {
+func (m *ReleaseAddressRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4664,17 +11148,17 @@ func (m *PoolAddRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4684,26 +11168,30 @@ func (m *PoolAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkID = string(data[iNdEx:postIndex])
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
+			}
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4713,34 +11201,26 @@ func (m *PoolAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.BindingID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLenmapkey uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4750,71 +11230,46 @@ func (m *PoolAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			var valuekey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				valuekey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
 			}
-			var stringLenmapvalue uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
-				return io.ErrUnexpectedEOF
-			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
+			m.Hard = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
-			m.Maximum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4824,16 +11279,76 @@ func (m *PoolAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Maximum |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
 			}
-			m.Type = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseAddressResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -4843,11 +11358,25 @@ func (m *PoolAddRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Type |= (Pool_Type(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Binding == nil {
+				m.Binding = &Binding{}
+			}
+			if err := m.Binding.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -4869,7 +11398,7 @@ func (m *PoolAddRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *PoolAddResponse) Unmarshal(data []byte) error {
+func (m *RenewBindingRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4892,15 +11421,15 @@ func (m *PoolAddResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAddResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenewBindingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenewBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4924,13 +11453,42 @@ func (m *PoolAddResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
+			if m.PoolID == nil {
+				m.PoolID = &Pool_PoolID{}
 			}
-			if err := m.Pool.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindingID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -4952,7 +11510,7 @@ func (m *PoolAddResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *PoolRemoveRequest) Unmarshal(data []byte) error {
+func (m *RenewBindingResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4975,15 +11533,15 @@ func (m *PoolRemoveRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenewBindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenewBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5007,10 +11565,10 @@ func (m *PoolRemoveRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ID == nil {
-				m.ID = &Pool_PoolID{}
+			if m.Binding == nil {
+				m.Binding = &Binding{}
 			}
-			if err := m.ID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Binding.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5035,7 +11593,7 @@ func (m *PoolRemoveRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *PoolRemoveResponse) Unmarshal(data []byte) error {
+func (m *AddressHistoryRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5058,12 +11616,118 @@ func (m *PoolRemoveResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Size_ |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuationToken = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -5085,7 +11749,7 @@ func (m *PoolRemoveResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *PoolSetMaxRequest) Unmarshal(data []byte) error {
+func (m *AddressHistoryResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5108,15 +11772,15 @@ func (m *PoolSetMaxRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolSetMaxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolSetMaxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5140,18 +11804,16 @@ func (m *PoolSetMaxRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolID == nil {
-				m.PoolID = &Pool_PoolID{}
-			}
-			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Bindings = append(m.Bindings, &Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			m.Maximum = 0
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5161,61 +11823,40 @@ func (m *PoolSetMaxRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Maximum |= (uint64(b) & 0x7F) << shift
+				m.Size_ |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPostal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPostal
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolSetMaxResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolSetMaxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolSetMaxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.ContinuationToken = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -5237,7 +11878,7 @@ func (m *PoolSetMaxResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *BindingRangeRequest) Unmarshal(data []byte) error {
+func (m *WatchRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5260,17 +11901,17 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BindingRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BindingRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5280,26 +11921,19 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkID = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5309,16 +11943,12 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Size_ |= (int32(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
-			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5328,19 +11958,22 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5350,12 +11983,12 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				valuekey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			var stringLenmapvalue uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5365,22 +11998,31 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			var valuekey uint64
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Filters == nil {
+				m.Filters = make(map[string]string)
+			}
+			m.Filters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5390,12 +12032,16 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				valuekey |= (uint64(b) & 0x7F) << shift
+				m.Revision |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapvalue uint64
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5405,25 +12051,20 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Filters == nil {
-				m.Filters = make(map[string]string)
-			}
-			m.Filters[mapkey] = mapvalue
+			m.Selector = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5446,7 +12087,7 @@ func (m *BindingRangeRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *BindingRangeResponse) Unmarshal(data []byte) error {
+func (m *WatchResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5469,15 +12110,15 @@ func (m *BindingRangeResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BindingRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BindingRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5501,16 +12142,16 @@ func (m *BindingRangeResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bindings = append(m.Bindings, &Binding{})
-			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			m.Size_ = 0
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5520,7 +12161,7 @@ func (m *BindingRangeResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Size_ |= (int32(b) & 0x7F) << shift
+				m.Revision |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5546,7 +12187,7 @@ func (m *BindingRangeResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *AllocateAddressRequest) Unmarshal(data []byte) error {
+func (m *RoleRangeRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5569,17 +12210,17 @@ func (m *AllocateAddressRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocateAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocateAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5589,30 +12230,76 @@ func (m *AllocateAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolID == nil {
-				m.PoolID = &Pool_PoolID{}
-			}
-			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if skippy < 0 {
+				return ErrInvalidLengthPostal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleRangeResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5622,20 +12309,22 @@ func (m *AllocateAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(data[iNdEx:postIndex])
+			m.Roles = append(m.Roles, &Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5658,7 +12347,7 @@ func (m *AllocateAddressRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *AllocateAddressResponse) Unmarshal(data []byte) error {
+func (m *RoleSetRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5681,15 +12370,15 @@ func (m *AllocateAddressResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocateAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocateAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5713,10 +12402,10 @@ func (m *AllocateAddressResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Binding == nil {
-				m.Binding = &Binding{}
+			if m.Role == nil {
+				m.Role = &Role{}
 			}
-			if err := m.Binding.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Role.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5741,7 +12430,7 @@ func (m *AllocateAddressResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *BulkAllocateAddressRequest) Unmarshal(data []byte) error {
+func (m *RoleSetResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5764,15 +12453,15 @@ func (m *BulkAllocateAddressRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkAllocateAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkAllocateAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5796,42 +12485,13 @@ func (m *BulkAllocateAddressRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolID == nil {
-				m.PoolID = &Pool_PoolID{}
+			if m.Role == nil {
+				m.Role = &Role{}
 			}
-			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Role.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cidr = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -5853,7 +12513,7 @@ func (m *BulkAllocateAddressRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *BulkAllocateAddressResponse) Unmarshal(data []byte) error {
+func (m *RoleRemoveRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5876,17 +12536,17 @@ func (m *BulkAllocateAddressResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkAllocateAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleRemoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkAllocateAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -5896,139 +12556,71 @@ func (m *BulkAllocateAddressResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bindings = append(m.Bindings, &Binding{})
-			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var stringLenmapkey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostal(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			var valuekey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				valuekey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var mapmsglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				mapmsglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if mapmsglen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postmsgIndex := iNdEx + mapmsglen
-			if mapmsglen < 0 {
-				return ErrInvalidLengthPostal
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleRemoveResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostal
 			}
-			if postmsgIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			mapvalue := &Error{}
-			if err := mapvalue.Unmarshal(data[iNdEx:postmsgIndex]); err != nil {
-				return err
-			}
-			iNdEx = postmsgIndex
-			if m.Errors == nil {
-				m.Errors = make(map[string]*Error)
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Errors[mapkey] = mapvalue
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleRemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -6050,7 +12642,7 @@ func (m *BulkAllocateAddressResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *BindAddressRequest) Unmarshal(data []byte) error {
+func (m *AuditRangeRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6073,17 +12665,17 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BindAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BindAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6093,28 +12685,33 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Start |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PoolID == nil {
-				m.PoolID = &Pool_PoolID{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.End |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6139,13 +12736,13 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(data[iNdEx:postIndex])
+			m.NetworkID = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6155,19 +12752,26 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.PoolID = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6177,12 +12781,26 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6192,22 +12810,26 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(data[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			var valuekey uint64
+			m.Identity = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6217,12 +12839,26 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				valuekey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapvalue uint64
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6232,81 +12868,16 @@ func (m *BindAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				m.Size_ |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
-				return io.ErrUnexpectedEOF
-			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPostal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPostal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BindAddressResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BindAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BindAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6316,24 +12887,20 @@ func (m *BindAddressResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPostal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Binding == nil {
-				m.Binding = &Binding{}
-			}
-			if err := m.Binding.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContinuationToken = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6356,7 +12923,7 @@ func (m *BindAddressResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ReleaseAddressRequest) Unmarshal(data []byte) error {
+func (m *AuditRangeResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6379,15 +12946,15 @@ func (m *ReleaseAddressRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReleaseAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReleaseAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6411,18 +12978,16 @@ func (m *ReleaseAddressRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolID == nil {
-				m.PoolID = &Pool_PoolID{}
-			}
-			if err := m.PoolID.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &AuditEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BindingID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var stringLen uint64
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPostal
@@ -6432,24 +12997,14 @@ func (m *ReleaseAddressRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Size_ |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPostal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BindingID = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6474,78 +13029,8 @@ func (m *ReleaseAddressRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(data[iNdEx:postIndex])
+			m.ContinuationToken = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPostal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hard = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPostal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPostal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReleaseAddressResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPostal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReleaseAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReleaseAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPostal(data[iNdEx:])
@@ -6673,79 +13158,167 @@ var (
 )
 
 var fileDescriptorPostal = []byte{
-	// 1176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xee, 0xae, 0xff, 0x9a, 0xe3, 0x90, 0xba, 0xd3, 0xfc, 0xb8, 0x9b, 0x36, 0x35, 0x2b, 0x68,
-	0x23, 0x40, 0x1b, 0x64, 0x7e, 0x84, 0xa2, 0x14, 0x70, 0xea, 0x44, 0x18, 0xd1, 0x0a, 0x2d, 0x91,
-	0x28, 0x88, 0x9b, 0x4d, 0x3c, 0x4d, 0x97, 0xd8, 0x5e, 0xb3, 0xbb, 0x0e, 0x0d, 0xef, 0xc0, 0x3d,
-	0x4f, 0xc1, 0x05, 0x17, 0x5c, 0x22, 0x84, 0xb8, 0xe0, 0x0a, 0x71, 0xc1, 0x03, 0x20, 0xe0, 0x11,
-	0x78, 0x00, 0x66, 0x67, 0xce, 0xee, 0xce, 0xd8, 0xe3, 0x24, 0x8d, 0xdb, 0x8b, 0x54, 0xbb, 0xe7,
-	0xcc, 0xf9, 0xd9, 0x6f, 0xbe, 0xf9, 0xce, 0xd4, 0x70, 0xe7, 0xd0, 0x8f, 0x1f, 0x8f, 0xf6, 0x9d,
-	0x83, 0xa0, 0xbf, 0xf1, 0xa5, 0x7f, 0x4c, 0x37, 0x86, 0x41, 0x14, 0x7b, 0xbd, 0x0d, 0x6f, 0xe8,
-	0xe3, 0xa3, 0x33, 0x0c, 0x83, 0x38, 0x20, 0x05, 0x66, 0xb1, 0x5f, 0x84, 0xd2, 0x4e, 0x18, 0x06,
-	0x21, 0xa9, 0x43, 0xa5, 0x4f, 0xa3, 0xc8, 0x3b, 0xa4, 0x75, 0xa3, 0x61, 0xac, 0xcf, 0xb9, 0xe9,
-	0xab, 0x5d, 0x61, 0x4b, 0xfa, 0xc3, 0xf8, 0xc4, 0xfe, 0xde, 0x80, 0xca, 0x03, 0x1a, 0x7f, 0x1d,
-	0x84, 0x47, 0x64, 0x01, 0xcc, 0x4e, 0x1b, 0x57, 0x9a, 0x7e, 0x9b, 0xbc, 0x07, 0x55, 0x6f, 0x30,
-	0x08, 0x62, 0x2f, 0xf6, 0x83, 0x41, 0x54, 0x37, 0x1b, 0x85, 0xf5, 0x6a, 0xf3, 0xa6, 0xc3, 0x4a,
-	0x38, 0x18, 0xe2, 0xb4, 0x72, 0xff, 0xce, 0x20, 0x0e, 0x4f, 0x5c, 0x39, 0x82, 0x10, 0x28, 0x1e,
-	0xf8, 0xdd, 0xb0, 0x5e, 0xe0, 0x29, 0xf9, 0xb3, 0xf5, 0x2e, 0xd4, 0xc6, 0x83, 0x48, 0x0d, 0x0a,
-	0x47, 0xf4, 0x04, 0x2b, 0x27, 0x8f, 0x64, 0x11, 0x4a, 0xc7, 0x5e, 0x6f, 0x44, 0x59, 0xd1, 0xc4,
-	0x26, 0x5e, 0x36, 0xcd, 0x77, 0x0c, 0xfb, 0x77, 0x13, 0x8a, 0x1f, 0x07, 0x41, 0x8f, 0x34, 0xb2,
-	0x6e, 0xab, 0xcd, 0x1a, 0x6f, 0x2a, 0x31, 0xf3, 0x7f, 0x3a, 0x6d, 0xde, 0xff, 0x96, 0xae, 0x7f,
-	0x2b, 0x5f, 0x7a, 0x7a, 0xf3, 0xaf, 0x40, 0xad, 0xef, 0x3d, 0xf1, 0xfb, 0xa3, 0x7e, 0xab, 0xdb,
-	0x0d, 0x19, 0x6e, 0x34, 0xe2, 0x1f, 0x52, 0x74, 0x27, 0xec, 0xc4, 0x86, 0x62, 0x7c, 0x32, 0xa4,
-	0xf5, 0x22, 0xf3, 0x2f, 0x34, 0x17, 0xf2, 0x12, 0x7b, 0xcc, 0xea, 0x72, 0x9f, 0xf5, 0x36, 0x94,
-	0x45, 0x6f, 0xe4, 0x06, 0xcc, 0x0d, 0x04, 0x7e, 0x19, 0xdc, 0xb9, 0x01, 0x77, 0xc1, 0x4c, 0x77,
-	0x61, 0x66, 0xc0, 0xd6, 0xa0, 0x98, 0x74, 0x41, 0xaa, 0x50, 0x69, 0x7f, 0xf6, 0xa0, 0x75, 0xbf,
-	0x73, 0xaf, 0x76, 0x89, 0xcc, 0x41, 0x69, 0xb7, 0xf3, 0x70, 0xa7, 0x5d, 0x33, 0xec, 0x9f, 0x4d,
-	0xa8, 0x6c, 0xfb, 0x83, 0xae, 0x3f, 0x38, 0x24, 0xeb, 0x50, 0x1e, 0xf2, 0x1e, 0xa7, 0xe2, 0x8a,
-	0xfe, 0xf1, 0x2e, 0xc7, 0xb9, 0x52, 0x90, 0xb8, 0x82, 0xc9, 0xcf, 0x80, 0x9b, 0x71, 0xd5, 0x13,
-	0x78, 0x72, 0x14, 0x19, 0x57, 0xf1, 0x95, 0x81, 0x3b, 0xef, 0xf5, 0x7a, 0xc1, 0x81, 0x17, 0xd3,
-	0x3d, 0xbf, 0x4f, 0xeb, 0x25, 0xe6, 0x2e, 0xb8, 0x8a, 0x8d, 0x58, 0x70, 0x79, 0x9f, 0x95, 0xe1,
-	0xfe, 0x32, 0xf7, 0x67, 0xef, 0x8c, 0x28, 0xd5, 0x90, 0xf6, 0xa8, 0x17, 0x89, 0xf0, 0x0a, 0x77,
-	0xcb, 0xa6, 0x99, 0x21, 0xfe, 0xd1, 0x80, 0x6b, 0x78, 0x22, 0x5c, 0x6f, 0x70, 0x48, 0x5d, 0xfa,
-	0xd5, 0x88, 0x46, 0xf1, 0xc4, 0x81, 0x62, 0xe7, 0x21, 0xf2, 0xbf, 0x11, 0x09, 0x4a, 0x2e, 0x7f,
-	0x66, 0xc0, 0x55, 0x1e, 0xf9, 0xbd, 0x98, 0x86, 0x29, 0x68, 0x2f, 0xcb, 0x07, 0x4c, 0x4e, 0xe7,
-	0xec, 0x8a, 0x75, 0x02, 0xbc, 0x34, 0xca, 0xda, 0x84, 0x79, 0xd9, 0xf1, 0x54, 0x8d, 0xef, 0xc1,
-	0xa2, 0x5a, 0x28, 0x1a, 0x32, 0x00, 0x28, 0xe3, 0xc1, 0x65, 0x24, 0x64, 0xc4, 0x12, 0x25, 0x5d,
-	0xcd, 0x2b, 0x5d, 0x65, 0x5e, 0xdd, 0x27, 0xd9, 0x3f, 0x18, 0x70, 0x15, 0x57, 0xb2, 0x23, 0x92,
-	0x82, 0xd1, 0x51, 0x19, 0x22, 0xd2, 0xde, 0x91, 0xd3, 0xe6, 0x8b, 0xcf, 0xa9, 0x2b, 0xe6, 0x33,
-	0xd4, 0x95, 0x2d, 0x20, 0x72, 0x1b, 0x08, 0xc4, 0x6d, 0xa8, 0xe0, 0xa7, 0xe2, 0x89, 0x50, 0x71,
-	0x48, 0x9d, 0xf6, 0xed, 0x1c, 0x48, 0xda, 0x0f, 0x8e, 0xa7, 0x31, 0xc0, 0x5e, 0x81, 0xa5, 0xb1,
-	0x75, 0xa2, 0x90, 0xfd, 0x8b, 0x01, 0xb5, 0xe4, 0x88, 0x29, 0xfc, 0x39, 0x5b, 0xe2, 0x74, 0x8c,
-	0xda, 0x1a, 0x67, 0x94, 0x9d, 0x85, 0x3e, 0x67, 0x3a, 0x7d, 0x00, 0x57, 0xa5, 0x2a, 0x08, 0xe1,
-	0x2d, 0x28, 0x25, 0x9a, 0x91, 0xee, 0xf8, 0x5c, 0xde, 0x8c, 0xb0, 0x6b, 0x29, 0xf4, 0x9f, 0x01,
-	0x0b, 0xc9, 0x1a, 0x89, 0x3f, 0xa7, 0xab, 0xe6, 0xae, 0x4e, 0xeb, 0x5f, 0xca, 0x6a, 0x9d, 0x9b,
-	0x5a, 0xc9, 0xc8, 0x14, 0xea, 0x8e, 0x62, 0x9f, 0xbe, 0x9e, 0x4b, 0xe3, 0x67, 0x25, 0xe1, 0xeb,
-	0x70, 0x25, 0xeb, 0x16, 0xe1, 0xbb, 0x09, 0xc5, 0x04, 0x26, 0x64, 0x81, 0x84, 0x1e, 0x37, 0xdb,
-	0x6f, 0x21, 0xe4, 0x0a, 0xeb, 0xce, 0xe4, 0x8d, 0xbd, 0x08, 0x44, 0x0e, 0x43, 0x12, 0x7e, 0x2a,
-	0x92, 0x7d, 0x42, 0xe3, 0xfb, 0xde, 0x93, 0x34, 0xd9, 0xf9, 0x67, 0x82, 0x84, 0x9d, 0xa9, 0x60,
-	0x97, 0x96, 0x4b, 0x13, 0x63, 0xb9, 0x5f, 0x99, 0x6c, 0xe2, 0x70, 0x50, 0x68, 0x7f, 0xfa, 0x4e,
-	0xa7, 0x74, 0x29, 0xe8, 0x45, 0xb4, 0x28, 0x89, 0xa8, 0x26, 0xf9, 0xf3, 0x11, 0x51, 0xb5, 0x50,
-	0x2e, 0xa2, 0xfb, 0xc2, 0xae, 0x8a, 0x68, 0xba, 0x38, 0xf3, 0x6a, 0x4f, 0xc0, 0x17, 0xb0, 0xdc,
-	0xc2, 0x09, 0x87, 0xf7, 0x8c, 0x0b, 0x6d, 0x48, 0x3a, 0x53, 0x4d, 0x65, 0xa6, 0xda, 0x2d, 0x58,
-	0x99, 0xc8, 0x9e, 0x4b, 0x1e, 0x36, 0xa6, 0x48, 0x5e, 0xda, 0x75, 0xea, 0xb4, 0x3f, 0x07, 0x6b,
-	0x7b, 0xd4, 0x3b, 0x9a, 0xb9, 0x49, 0x8d, 0x98, 0xdb, 0x7f, 0x1a, 0xb0, 0xaa, 0x4d, 0xfe, 0xd4,
-	0xd0, 0xb6, 0xa1, 0x4c, 0x93, 0xbb, 0x70, 0x2a, 0x09, 0xaf, 0x89, 0x75, 0xd3, 0x73, 0x3b, 0xfc,
-	0xea, 0x8c, 0xfc, 0xc0, 0x58, 0x6b, 0x07, 0xaa, 0x92, 0x59, 0xc3, 0x8e, 0x86, 0xcc, 0x8e, 0x6a,
-	0x13, 0x78, 0x15, 0x1e, 0x22, 0x33, 0xe5, 0x5f, 0x03, 0x48, 0xd2, 0xe2, 0xb3, 0xdf, 0x50, 0xf2,
-	0xa1, 0xee, 0xfe, 0xb5, 0x9e, 0x81, 0xa2, 0x56, 0x3c, 0x5d, 0x03, 0x67, 0x56, 0xb1, 0xbb, 0xe2,
-	0x58, 0x5f, 0x94, 0x58, 0xdf, 0x1a, 0xb0, 0xe4, 0x8a, 0xdb, 0xd9, 0x85, 0x81, 0x62, 0x12, 0x82,
-	0xe9, 0xb2, 0x5b, 0x6a, 0x6e, 0x90, 0x61, 0x2c, 0xa8, 0x30, 0x32, 0x32, 0x3e, 0xf6, 0xc2, 0x2e,
-	0x17, 0xf9, 0xcb, 0x2e, 0x7f, 0xb6, 0xeb, 0xb0, 0x3c, 0xde, 0x8e, 0xf8, 0xa2, 0xe6, 0x4f, 0xe5,
-	0xe4, 0x4e, 0x9f, 0xfc, 0xf7, 0x8b, 0xdc, 0x83, 0x79, 0xf9, 0x26, 0x45, 0xea, 0xd3, 0x6e, 0x71,
-	0xd6, 0x75, 0x8d, 0x07, 0x11, 0xba, 0x0b, 0x90, 0xdf, 0x41, 0xc8, 0xb2, 0xfe, 0x6e, 0x64, 0xad,
-	0x4c, 0xd8, 0x31, 0x7c, 0x17, 0x5e, 0x50, 0x2e, 0x17, 0x44, 0x2d, 0x25, 0x8f, 0x08, 0xcb, 0xd2,
-	0xb9, 0x30, 0xcf, 0x26, 0xcc, 0x65, 0x63, 0x9c, 0x2c, 0x69, 0x2f, 0x0f, 0xd6, 0xf2, 0xb8, 0x19,
-	0x63, 0xdf, 0x84, 0x0a, 0x4e, 0x30, 0x72, 0x4d, 0x33, 0x7d, 0xad, 0x45, 0xd5, 0x98, 0x7f, 0x78,
-	0x3e, 0x8e, 0x88, 0x94, 0x5b, 0xe9, 0x79, 0x65, 0xc2, 0xae, 0x86, 0x8b, 0xf1, 0x22, 0x85, 0x2b,
-	0x83, 0x4c, 0x0a, 0x57, 0xe7, 0x50, 0xb2, 0x77, 0xb2, 0x80, 0xe3, 0xde, 0x69, 0x86, 0x07, 0xee,
-	0x9d, 0x56, 0xed, 0x3f, 0x82, 0x2b, 0x63, 0x8a, 0x42, 0x56, 0xf9, 0x6a, 0xbd, 0x40, 0x5a, 0x37,
-	0xf4, 0x4e, 0xcc, 0xf6, 0x90, 0x1d, 0xa1, 0x49, 0x8d, 0x22, 0xb7, 0xa6, 0xab, 0x97, 0xc8, 0xda,
-	0x38, 0x4b, 0xde, 0xc8, 0xfb, 0x50, 0x95, 0x0e, 0x27, 0x59, 0x99, 0x22, 0x11, 0x56, 0x7d, 0xd2,
-	0x81, 0x19, 0x3a, 0xb0, 0xa0, 0x9e, 0x07, 0x22, 0xc8, 0xa4, 0x3d, 0xb3, 0xd6, 0xaa, 0xd6, 0x27,
-	0x52, 0x6d, 0xbf, 0xfa, 0xdb, 0xdf, 0x6b, 0xc6, 0x1f, 0xec, 0xef, 0x2f, 0xf6, 0xf7, 0xdd, 0x3f,
-	0x6b, 0x97, 0xe0, 0xfa, 0x41, 0xd0, 0x77, 0x92, 0x9f, 0x38, 0x1c, 0x7f, 0xf0, 0x28, 0xf4, 0x1c,
-	0xfc, 0x75, 0x83, 0xe5, 0xd8, 0x2f, 0xf3, 0x9f, 0x38, 0xde, 0xf8, 0x3f, 0x00, 0x00, 0xff, 0xff,
-	0x30, 0x15, 0xf1, 0x09, 0x0d, 0x11, 0x00, 0x00,
+	// 2585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x1e, 0x7e, 0xf3, 0x51, 0x96, 0xa9, 0x11, 0x25, 0x51, 0x6b, 0x5b, 0x61, 0x36, 0xf9, 0xd9,
+	0xb2, 0x92, 0x90, 0x89, 0x7e, 0x81, 0x91, 0x3a, 0xae, 0x1d, 0xda, 0xa4, 0x51, 0xc5, 0xf2, 0x47,
+	0x56, 0x72, 0x6c, 0x1f, 0x0a, 0x63, 0x4d, 0x8e, 0xe5, 0xad, 0xc9, 0x5d, 0x66, 0xb9, 0x94, 0xc3,
+	0x1a, 0x06, 0x1a, 0xa7, 0x40, 0x2f, 0x45, 0x8a, 0x22, 0x97, 0x1e, 0x7a, 0x2c, 0xd0, 0x5b, 0x0f,
+	0x45, 0x7b, 0xeb, 0xa9, 0xe8, 0x21, 0x87, 0x1e, 0x0a, 0xb4, 0x7f, 0x40, 0xe1, 0xf4, 0xd6, 0x4b,
+	0x6f, 0xbd, 0x16, 0xf3, 0xb1, 0xbb, 0xb3, 0xcb, 0x21, 0x25, 0x4a, 0x4a, 0x83, 0x5e, 0xec, 0x9d,
+	0xf7, 0x66, 0xde, 0x7b, 0xf3, 0xbe, 0xe6, 0xbd, 0x47, 0xc1, 0xd9, 0x1d, 0xcb, 0x7b, 0x3c, 0x78,
+	0x58, 0x6d, 0x39, 0xdd, 0xda, 0x0f, 0xac, 0x5d, 0x52, 0xeb, 0x39, 0x7d, 0xcf, 0xec, 0xd4, 0xcc,
+	0x9e, 0x25, 0x3e, 0xab, 0x3d, 0xd7, 0xf1, 0x1c, 0x9c, 0x34, 0x7b, 0x96, 0x76, 0x6a, 0xc7, 0x71,
+	0x76, 0x3a, 0x84, 0x61, 0x4d, 0xdb, 0x76, 0x3c, 0xd3, 0xb3, 0x1c, 0xbb, 0xcf, 0xb7, 0xe8, 0xaf,
+	0x42, 0xba, 0xe9, 0xba, 0x8e, 0x8b, 0xcb, 0x90, 0xed, 0x92, 0x7e, 0xdf, 0xdc, 0x21, 0x65, 0x54,
+	0x41, 0xab, 0x79, 0xc3, 0x5f, 0xea, 0x59, 0x48, 0x37, 0xbb, 0x3d, 0x6f, 0xa8, 0xff, 0x06, 0x41,
+	0xf6, 0x26, 0xf1, 0x9e, 0x3a, 0xee, 0x13, 0x3c, 0x0b, 0x89, 0x8d, 0x86, 0xd8, 0x99, 0xb0, 0x1a,
+	0xf8, 0x32, 0x14, 0x24, 0xe2, 0xe5, 0x44, 0x25, 0xb9, 0x5a, 0x58, 0x3f, 0x5d, 0x35, 0x7b, 0x56,
+	0x55, 0x1c, 0xa9, 0xd6, 0x43, 0x7c, 0xd3, 0xf6, 0xdc, 0xa1, 0x21, 0x9f, 0xc0, 0x18, 0x52, 0x2d,
+	0xab, 0xed, 0x96, 0x93, 0x8c, 0x24, 0xfb, 0xd6, 0x2e, 0x41, 0x31, 0x7e, 0x08, 0x17, 0x21, 0xf9,
+	0x84, 0x0c, 0x05, 0x67, 0xfa, 0x89, 0x4b, 0x90, 0xde, 0x35, 0x3b, 0x03, 0x52, 0x4e, 0x30, 0x18,
+	0x5f, 0x5c, 0x48, 0xbc, 0x87, 0xf4, 0x7f, 0x26, 0x21, 0x75, 0xdb, 0x71, 0x3a, 0xb8, 0x12, 0x48,
+	0x5b, 0x58, 0x2f, 0x32, 0xa1, 0x28, 0x98, 0xfd, 0xb3, 0xd1, 0x60, 0xf2, 0x5f, 0x54, 0xc9, 0xaf,
+	0x85, 0x5b, 0x27, 0x0b, 0xbf, 0x06, 0xc5, 0xae, 0xf9, 0xa9, 0xd5, 0x1d, 0x74, 0xeb, 0xed, 0xb6,
+	0x4b, 0xfa, 0x7d, 0xd2, 0x67, 0x17, 0x49, 0x19, 0x23, 0x70, 0xac, 0x43, 0xca, 0x1b, 0xf6, 0x48,
+	0x39, 0x55, 0x41, 0xab, 0xb3, 0xeb, 0xb3, 0x21, 0x8b, 0xed, 0x61, 0x8f, 0x18, 0x0c, 0x87, 0x57,
+	0x00, 0x3e, 0x19, 0x98, 0xae, 0x69, 0x7b, 0x96, 0x4d, 0xca, 0xe9, 0x0a, 0x5a, 0x4d, 0x1a, 0x12,
+	0x04, 0x57, 0x21, 0xd7, 0xf7, 0x5c, 0xd3, 0x23, 0x3b, 0xc3, 0x72, 0x86, 0xd1, 0xc1, 0x21, 0x9d,
+	0x2d, 0x81, 0x31, 0x82, 0x3d, 0xda, 0x79, 0xc8, 0xf0, 0xbb, 0xe2, 0x53, 0x90, 0xb7, 0xb9, 0x3d,
+	0x02, 0xf3, 0x85, 0x00, 0x61, 0xd5, 0x84, 0x6f, 0xd5, 0x43, 0x1b, 0x60, 0x05, 0x52, 0xf4, 0x56,
+	0xb8, 0x00, 0xd9, 0xc6, 0xfd, 0x9b, 0xf5, 0x1b, 0x1b, 0x57, 0x8b, 0xc7, 0x70, 0x1e, 0xd2, 0xd7,
+	0x36, 0xee, 0x35, 0x1b, 0x45, 0xa4, 0x5f, 0x87, 0x9c, 0x2f, 0x2d, 0x06, 0xc8, 0x6c, 0xde, 0xba,
+	0xdb, 0xdc, 0xda, 0x2e, 0x1e, 0xa3, 0xdf, 0x46, 0xfd, 0x66, 0xe3, 0xd6, 0x8d, 0x22, 0xc2, 0x27,
+	0x61, 0x69, 0xb3, 0x59, 0xdf, 0xda, 0x7e, 0x60, 0x34, 0xaf, 0x36, 0x6f, 0x6e, 0x6f, 0xde, 0x7f,
+	0x60, 0x34, 0x29, 0xa0, 0xd9, 0x28, 0x26, 0xe8, 0xc6, 0xad, 0xed, 0x8d, 0xab, 0xd7, 0xef, 0x17,
+	0x93, 0xfa, 0xef, 0x92, 0x90, 0xbd, 0x62, 0xd9, 0x6d, 0xcb, 0xde, 0xc1, 0xab, 0x90, 0xe9, 0xb1,
+	0x0b, 0x8f, 0x35, 0xba, 0xc0, 0xc7, 0xaf, 0x1c, 0x77, 0xe4, 0xa4, 0xe4, 0xc8, 0x82, 0xf8, 0x1e,
+	0xbe, 0x50, 0x86, 0xac, 0xc9, 0x8d, 0xcd, 0x4c, 0x9c, 0x37, 0xfc, 0x25, 0xd6, 0x61, 0xc6, 0xec,
+	0x74, 0x9c, 0x96, 0xe9, 0x91, 0x6d, 0xab, 0xeb, 0xdb, 0x35, 0x02, 0xc3, 0x1a, 0xe4, 0x1e, 0x5a,
+	0x76, 0x9b, 0xe1, 0x33, 0x0c, 0x1f, 0xac, 0x71, 0x05, 0x0a, 0x2e, 0xe9, 0x10, 0xb3, 0xcf, 0x8f,
+	0x67, 0x19, 0x5a, 0x06, 0x51, 0xdb, 0x78, 0x5e, 0xa7, 0x9c, 0x63, 0x18, 0xfa, 0x89, 0x57, 0xe1,
+	0x44, 0xe8, 0x37, 0x77, 0x6c, 0xcf, 0xea, 0x94, 0xf3, 0x0c, 0x1b, 0x07, 0x53, 0xea, 0xe6, 0xa3,
+	0x47, 0x96, 0x6d, 0x79, 0xc3, 0xeb, 0x64, 0x58, 0x06, 0x26, 0xbb, 0x0c, 0xa2, 0x76, 0x76, 0x9e,
+	0xda, 0xc4, 0x2d, 0x17, 0xb8, 0x9d, 0xd9, 0xe2, 0xd0, 0x3e, 0xf2, 0x2f, 0x04, 0xe9, 0xe6, 0x2e,
+	0xb1, 0x3d, 0xfc, 0x9a, 0x88, 0x0c, 0xc4, 0x3c, 0xfa, 0x04, 0xd3, 0x39, 0xc3, 0xc8, 0xa1, 0x71,
+	0x06, 0xb2, 0xc2, 0x5f, 0x19, 0xa9, 0xc2, 0xfa, 0x8c, 0x9c, 0x64, 0x0c, 0x1f, 0x89, 0x4f, 0x43,
+	0x8a, 0x5a, 0x98, 0x85, 0x61, 0x61, 0x3d, 0x1f, 0xd8, 0xdf, 0x60, 0x60, 0x4a, 0xe6, 0x21, 0x37,
+	0x67, 0x39, 0x25, 0x91, 0x11, 0x26, 0x36, 0x7c, 0xa4, 0xbe, 0x15, 0x7a, 0xf0, 0x55, 0xa3, 0x59,
+	0xdf, 0x6e, 0x36, 0x8a, 0xc7, 0xe8, 0xe2, 0xce, 0xed, 0x06, 0x5b, 0x20, 0xea, 0xce, 0x57, 0x6e,
+	0xdd, 0xb9, 0x49, 0xbd, 0x71, 0x06, 0x72, 0x81, 0x6f, 0x26, 0xe9, 0xae, 0xe6, 0xbd, 0xdb, 0x1b,
+	0x46, 0xb3, 0x51, 0x4c, 0xd1, 0x45, 0xa3, 0xb9, 0xd9, 0xa4, 0x47, 0xd2, 0xfa, 0x1f, 0x11, 0xa4,
+	0x0c, 0xa7, 0x43, 0x68, 0xd2, 0xb3, 0xcd, 0xae, 0x9f, 0x71, 0xd9, 0x37, 0xd5, 0xd4, 0xa0, 0x4f,
+	0x5c, 0x9e, 0x83, 0xf2, 0x06, 0x5f, 0xe0, 0x45, 0xc8, 0xec, 0xb8, 0xce, 0xa0, 0xc7, 0x3d, 0x32,
+	0x6f, 0x88, 0x15, 0x7e, 0x1d, 0xd2, 0xee, 0xa0, 0x43, 0xa8, 0xaf, 0x51, 0x47, 0xe5, 0xe9, 0x84,
+	0xd2, 0xae, 0x1a, 0x83, 0x0e, 0x31, 0x38, 0x52, 0x33, 0x20, 0x45, 0x97, 0x3c, 0xc9, 0x7b, 0x8f,
+	0x9d, 0x76, 0xbf, 0x8c, 0x18, 0x19, 0x7f, 0x49, 0xfd, 0x4e, 0x68, 0xce, 0x67, 0x1c, 0xac, 0xa9,
+	0x44, 0x54, 0x67, 0x3e, 0x6b, 0xbe, 0xd0, 0xff, 0x9c, 0x00, 0xa8, 0x0f, 0xda, 0x96, 0xc7, 0x4d,
+	0x1e, 0x7f, 0x10, 0x30, 0xa4, 0x3c, 0xea, 0xa5, 0x09, 0xe6, 0x6d, 0xec, 0x9b, 0x5e, 0x82, 0xf3,
+	0x13, 0x59, 0x5e, 0xac, 0x28, 0x73, 0xab, 0x4d, 0x6c, 0xcf, 0xf2, 0x86, 0x22, 0x66, 0x82, 0xb5,
+	0x74, 0xf1, 0x74, 0xe4, 0xe2, 0x65, 0xc8, 0xba, 0xe4, 0x93, 0x01, 0xe9, 0x7b, 0x2c, 0x4e, 0xf2,
+	0x86, 0xbf, 0x64, 0x2f, 0x89, 0xd3, 0xe6, 0xf1, 0x41, 0x5f, 0x12, 0xa7, 0xcd, 0x94, 0x4a, 0xe8,
+	0x33, 0xc7, 0x42, 0x23, 0x6f, 0xf0, 0x05, 0xe5, 0xeb, 0x92, 0x5d, 0xab, 0x6f, 0x39, 0xb6, 0x88,
+	0x8a, 0x60, 0x1d, 0x4d, 0x94, 0x10, 0x4f, 0x94, 0x8b, 0x41, 0x7e, 0xe1, 0xb1, 0x20, 0x56, 0xf4,
+	0x94, 0x19, 0xbc, 0x00, 0x33, 0x4c, 0xe0, 0x10, 0xc0, 0xf9, 0xf5, 0x3a, 0xe6, 0x90, 0xb4, 0xcb,
+	0xc7, 0x2b, 0x68, 0x35, 0x67, 0x04, 0x6b, 0xfd, 0x47, 0x09, 0x98, 0xf7, 0x9d, 0xd8, 0xb4, 0x77,
+	0x88, 0x21, 0x6e, 0xa3, 0xd0, 0x6b, 0xdf, 0xfa, 0x21, 0xd7, 0x6b, 0xda, 0x60, 0xdf, 0xf8, 0x32,
+	0x64, 0x1f, 0x59, 0x1d, 0x8f, 0xb8, 0xdc, 0x44, 0x85, 0xf5, 0xff, 0x8b, 0xc4, 0x84, 0x44, 0xae,
+	0x7a, 0x8d, 0xef, 0xe3, 0x79, 0xcb, 0x3f, 0x85, 0xdf, 0x84, 0xb9, 0x96, 0x43, 0x73, 0xc1, 0x80,
+	0x45, 0xf1, 0xb6, 0xf3, 0x84, 0xd8, 0xc2, 0x12, 0xa3, 0x08, 0x7a, 0x8d, 0x3e, 0xe9, 0x90, 0x96,
+	0xe7, 0xb8, 0x2c, 0x87, 0xe5, 0x8d, 0x60, 0xad, 0x5d, 0x80, 0x19, 0x99, 0xc5, 0x54, 0x99, 0xe0,
+	0x05, 0x82, 0x52, 0x54, 0xe6, 0x7e, 0xcf, 0xb1, 0xfb, 0x04, 0xaf, 0x4a, 0xce, 0x89, 0x2a, 0xc9,
+	0x20, 0x5a, 0xfd, 0xcd, 0x01, 0x56, 0xa9, 0x1d, 0xe5, 0xe5, 0x92, 0x63, 0x2e, 0xa7, 0xff, 0x16,
+	0xc1, 0x9c, 0xa0, 0x5b, 0x6f, 0xb7, 0x7d, 0x2b, 0x6c, 0x44, 0x5f, 0x05, 0x2e, 0xc4, 0x59, 0x59,
+	0x88, 0x70, 0xf3, 0x3e, 0x0b, 0x9d, 0xc4, 0x11, 0x16, 0x3a, 0x17, 0x01, 0xcb, 0x62, 0x08, 0xb5,
+	0x49, 0xa9, 0x12, 0x4d, 0x48, 0x95, 0xfa, 0xc5, 0x50, 0xed, 0xa4, 0xeb, 0xec, 0x8e, 0x75, 0xbd,
+	0x12, 0xa4, 0x1f, 0x39, 0x6e, 0x8b, 0xf3, 0xcf, 0x19, 0x7c, 0xa1, 0x2f, 0xc1, 0x42, 0xec, 0x34,
+	0x67, 0xaf, 0xff, 0x34, 0x01, 0x45, 0x96, 0x71, 0x65, 0x77, 0xde, 0xbb, 0x12, 0x53, 0x99, 0xf0,
+	0x62, 0xdc, 0xc1, 0xf5, 0xe0, 0xe8, 0xff, 0x8c, 0x77, 0xef, 0xc2, 0x9c, 0x24, 0xaf, 0x30, 0xd1,
+	0x2b, 0x7e, 0x6a, 0xe5, 0x1e, 0x25, 0x3d, 0x53, 0x1c, 0x7e, 0x04, 0x0e, 0xfd, 0xa7, 0x04, 0xcc,
+	0x52, 0x8a, 0x92, 0x37, 0x4f, 0x2e, 0x02, 0xaf, 0xa9, 0x4a, 0xe1, 0xd7, 0x03, 0xc9, 0xf6, 0xed,
+	0xe8, 0xf4, 0xb1, 0xe1, 0xc5, 0xaf, 0xa8, 0x85, 0xfd, 0xe5, 0xb7, 0x52, 0x02, 0x1f, 0x36, 0xc4,
+	0xde, 0x86, 0x13, 0xc1, 0xed, 0x85, 0xf1, 0xfc, 0x12, 0x03, 0x29, 0x4b, 0x0c, 0xfd, 0xba, 0x30,
+	0x78, 0x24, 0xa6, 0xf6, 0xf6, 0x7f, 0x75, 0x94, 0x95, 0x00, 0xcb, 0xc4, 0x44, 0x88, 0xdd, 0xe5,
+	0x2c, 0xb6, 0x88, 0x77, 0xc3, 0xfc, 0xd4, 0x67, 0xb1, 0xff, 0xda, 0x57, 0xb2, 0x50, 0x22, 0x62,
+	0x21, 0x9f, 0x9d, 0x4f, 0x58, 0xb0, 0xfb, 0x22, 0x01, 0xf3, 0x7e, 0x85, 0x24, 0x07, 0xf5, 0x64,
+	0x7f, 0xf2, 0x5d, 0x38, 0xa9, 0x7e, 0xb1, 0x52, 0xd2, 0x8b, 0xa5, 0x20, 0x3e, 0x4d, 0x4c, 0xa7,
+	0xf7, 0x13, 0xd3, 0x99, 0x23, 0x7e, 0xb1, 0xa2, 0x32, 0x87, 0x2f, 0x96, 0xa8, 0x20, 0xa3, 0x2f,
+	0x96, 0xbf, 0x39, 0xc0, 0x1e, 0x41, 0x80, 0xff, 0x18, 0xc1, 0x62, 0x5d, 0xf4, 0x10, 0xa2, 0xcd,
+	0x3c, 0x90, 0x2b, 0xf8, 0x5d, 0x4b, 0x22, 0xda, 0xb5, 0x9c, 0x81, 0x59, 0xab, 0x4d, 0xba, 0x3d,
+	0xc7, 0x23, 0x76, 0x8b, 0xb5, 0x06, 0x5c, 0x92, 0x18, 0x54, 0xaf, 0xc3, 0xd2, 0x88, 0x14, 0xe1,
+	0x43, 0xe4, 0x17, 0xdb, 0x68, 0x52, 0xb1, 0xfd, 0x02, 0x81, 0x76, 0x65, 0xd0, 0x79, 0x72, 0xe8,
+	0xdb, 0x28, 0xde, 0xd8, 0x7d, 0xdf, 0xe3, 0x6f, 0x08, 0x4e, 0x2a, 0x85, 0x98, 0xda, 0xb4, 0x0d,
+	0xc8, 0xb0, 0x3a, 0xd3, 0xcf, 0xa1, 0x6f, 0xf2, 0x7d, 0xe3, 0x69, 0x57, 0xd9, 0x28, 0x46, 0xb8,
+	0xba, 0x38, 0xab, 0x35, 0xa1, 0x20, 0x81, 0x15, 0xee, 0x59, 0x91, 0xdd, 0xb3, 0xb0, 0x0e, 0xbc,
+	0x6f, 0xa2, 0x47, 0x22, 0xae, 0x9a, 0x04, 0x4c, 0x45, 0xfc, 0x06, 0x3c, 0xe4, 0x43, 0x55, 0xcb,
+	0xbc, 0x1a, 0x28, 0x25, 0xca, 0x71, 0x8f, 0x47, 0x43, 0x74, 0xb0, 0xa9, 0xb0, 0x83, 0x5d, 0x83,
+	0xa2, 0xb5, 0x63, 0x3b, 0x2e, 0xf9, 0x28, 0xfa, 0x1c, 0xe4, 0x8c, 0x11, 0x78, 0xbc, 0x87, 0xcd,
+	0x4c, 0xe8, 0x61, 0xb3, 0x52, 0x0f, 0xab, 0xf0, 0x8d, 0x9c, 0xca, 0x37, 0x0e, 0xfd, 0x88, 0x7c,
+	0x17, 0xe6, 0x23, 0x1a, 0x99, 0x32, 0x3e, 0xbe, 0x4e, 0x41, 0x49, 0x3a, 0x4f, 0x0e, 0x60, 0xc5,
+	0x12, 0xa4, 0x5b, 0xce, 0xc0, 0xf6, 0x44, 0xbe, 0xe1, 0x0b, 0xbc, 0xa9, 0xb2, 0xe0, 0x5a, 0xdc,
+	0x82, 0x64, 0x9f, 0x36, 0xbc, 0x03, 0x58, 0x48, 0x2c, 0xed, 0x1b, 0xc9, 0xf3, 0x93, 0x88, 0x1a,
+	0x0a, 0x02, 0xbe, 0x6b, 0xa4, 0x43, 0xd7, 0x58, 0x84, 0x8c, 0xe9, 0x39, 0x5d, 0xab, 0xc5, 0x2c,
+	0x9d, 0x33, 0xc4, 0x4a, 0xe9, 0x32, 0xd9, 0x31, 0x2e, 0xf3, 0x5f, 0x32, 0xbd, 0xf6, 0x6b, 0x04,
+	0x05, 0xf9, 0x36, 0xf7, 0x54, 0x1d, 0xc5, 0xf9, 0x7d, 0x69, 0x67, 0xb2, 0xfa, 0x0f, 0xed, 0xa4,
+	0x5f, 0x21, 0x58, 0x88, 0x89, 0x30, 0x75, 0xea, 0xbb, 0x14, 0x4b, 0x7d, 0x67, 0x54, 0x17, 0xfb,
+	0xe6, 0x93, 0xde, 0xef, 0x11, 0x2c, 0x18, 0x7c, 0x3e, 0x76, 0xe0, 0xbc, 0x77, 0x0a, 0xf2, 0xe2,
+	0x5a, 0xc1, 0x9c, 0x30, 0x04, 0xc8, 0x59, 0x31, 0x19, 0xcd, 0x8a, 0x18, 0x52, 0x8f, 0x4d, 0xb7,
+	0xcd, 0x52, 0x59, 0xce, 0x60, 0xdf, 0x0a, 0x67, 0x4b, 0x2b, 0xdf, 0xa0, 0x0f, 0x60, 0x31, 0x2e,
+	0xf6, 0x94, 0xa9, 0xe2, 0xfb, 0x30, 0x6f, 0x10, 0x9b, 0x3c, 0xf5, 0x11, 0x47, 0x7b, 0x6d, 0xfd,
+	0x12, 0x94, 0xa2, 0xe4, 0xa7, 0x14, 0xef, 0xe7, 0x08, 0x16, 0xc4, 0xd5, 0xbe, 0x67, 0xf5, 0x3d,
+	0xc7, 0x1d, 0xee, 0xaf, 0x96, 0x1c, 0xff, 0x08, 0xa9, 0xaa, 0xcc, 0xa9, 0x1a, 0x3f, 0x5e, 0x47,
+	0xc5, 0x64, 0xfa, 0x16, 0xca, 0xb9, 0x3f, 0x20, 0x98, 0xb9, 0x6b, 0x7a, 0xad, 0xc7, 0xbe, 0x46,
+	0xde, 0x0b, 0x6b, 0x65, 0xce, 0x7b, 0x85, 0xf1, 0x96, 0xf7, 0x8c, 0x29, 0x92, 0xe5, 0xf9, 0x56,
+	0x22, 0x36, 0xdf, 0x92, 0x4b, 0xe2, 0xe4, 0x11, 0x96, 0xc4, 0xb7, 0xe0, 0xb8, 0x90, 0x4c, 0xe8,
+	0x4e, 0x87, 0x0c, 0xa1, 0x43, 0x5c, 0x5f, 0x7a, 0x08, 0xe7, 0xba, 0x86, 0xc0, 0x4c, 0x12, 0x54,
+	0x3f, 0x03, 0x45, 0xc3, 0xe9, 0x90, 0x48, 0xc3, 0xa1, 0x98, 0x9b, 0xea, 0xef, 0xc2, 0x9c, 0xb4,
+	0x2f, 0xec, 0xaf, 0x5d, 0xa7, 0x43, 0x7c, 0xde, 0xf9, 0x60, 0x3c, 0x6a, 0x70, 0xb8, 0x5e, 0x83,
+	0x59, 0xba, 0xdc, 0x22, 0x9e, 0x4f, 0xfb, 0x34, 0xa4, 0x28, 0x2a, 0xd2, 0xd5, 0xb1, 0x13, 0x0c,
+	0x4c, 0xfb, 0xc0, 0xe0, 0x40, 0xd8, 0x07, 0x4e, 0x3a, 0x71, 0x56, 0x08, 0x16, 0xe9, 0x03, 0x55,
+	0x37, 0x28, 0x01, 0x96, 0x37, 0x8a, 0xa6, 0xeb, 0xb3, 0x04, 0xcc, 0xb1, 0x39, 0x6b, 0x44, 0x03,
+	0x25, 0x48, 0xf7, 0x3d, 0xd3, 0xf5, 0x18, 0x81, 0xa4, 0xc1, 0x17, 0xd4, 0x50, 0xc4, 0x6e, 0x0b,
+	0x15, 0xd2, 0xcf, 0x68, 0x38, 0x25, 0xc7, 0x8f, 0x31, 0x53, 0x91, 0x31, 0xa6, 0x14, 0x66, 0xe9,
+	0x68, 0x98, 0xc9, 0xa3, 0xda, 0xcc, 0xe8, 0xa8, 0x56, 0x8c, 0x77, 0xb3, 0x91, 0xf1, 0xae, 0x1f,
+	0x13, 0xb9, 0xbd, 0x62, 0x22, 0x3f, 0x2e, 0x26, 0x3e, 0x43, 0x80, 0x65, 0x1d, 0x08, 0xc5, 0x9f,
+	0x83, 0x2c, 0xb1, 0x3d, 0xd7, 0x0a, 0xec, 0xcb, 0x7f, 0x33, 0x08, 0xa7, 0xd2, 0x86, 0x8f, 0x3f,
+	0x7c, 0x5c, 0xae, 0xff, 0x7b, 0x8e, 0xfe, 0x88, 0x46, 0x7f, 0x5d, 0xc5, 0xf7, 0x61, 0x46, 0x9e,
+	0x53, 0xe2, 0xf2, 0xb8, 0x71, 0xab, 0xb6, 0xac, 0xc0, 0x08, 0xbb, 0x96, 0x5e, 0xfc, 0xf5, 0x1f,
+	0x5f, 0x26, 0x66, 0xf1, 0x4c, 0x6d, 0xf7, 0x9d, 0x5a, 0x30, 0xc0, 0xfc, 0x18, 0x20, 0x9c, 0xe4,
+	0xe1, 0x45, 0xf5, 0x84, 0x51, 0x5b, 0x1a, 0x81, 0x0b, 0xa2, 0x4b, 0x8c, 0xe8, 0x9c, 0x1e, 0x21,
+	0x7a, 0x01, 0xad, 0x61, 0x13, 0x8e, 0x47, 0xa6, 0x74, 0x38, 0x2a, 0x99, 0xec, 0x9b, 0x9a, 0xa6,
+	0x42, 0x09, 0x06, 0xcb, 0x8c, 0xc1, 0xfc, 0xda, 0x9c, 0xcc, 0xa0, 0xf6, 0x6c, 0xa3, 0xf1, 0x1c,
+	0x13, 0xc8, 0x07, 0x03, 0x2e, 0xbc, 0xa0, 0x1c, 0xd0, 0x69, 0x8b, 0x71, 0xb0, 0x20, 0x7b, 0x8e,
+	0x91, 0x7d, 0x0d, 0xbf, 0x1a, 0x27, 0x5b, 0x0d, 0x9c, 0xf5, 0x79, 0x8d, 0x4f, 0xc4, 0x1e, 0x40,
+	0x56, 0x0c, 0x62, 0xf0, 0xbc, 0x62, 0x28, 0xa5, 0x95, 0xa2, 0xc0, 0x28, 0x03, 0x7d, 0x25, 0xca,
+	0x20, 0x4e, 0x9d, 0xaa, 0xaa, 0x07, 0x10, 0x8e, 0x5a, 0xb0, 0x24, 0x71, 0x44, 0x49, 0x4b, 0x23,
+	0x70, 0xc1, 0xe9, 0x1d, 0xc6, 0xe9, 0x8d, 0xb5, 0x73, 0x7b, 0x5e, 0x85, 0x01, 0xa9, 0xe6, 0x3e,
+	0x47, 0x00, 0xe1, 0xb8, 0x45, 0x62, 0x19, 0x19, 0xec, 0x68, 0x4b, 0x23, 0x70, 0xc1, 0xb2, 0xc1,
+	0x58, 0x5e, 0xd2, 0xbe, 0x13, 0x65, 0xc9, 0x83, 0x59, 0xc1, 0x56, 0x20, 0x28, 0x44, 0x0c, 0x7c,
+	0xe8, 0xbd, 0x6d, 0x98, 0x91, 0x67, 0x19, 0xc2, 0xab, 0x15, 0x23, 0x19, 0x6d, 0x59, 0x81, 0x99,
+	0x6c, 0x48, 0x49, 0x86, 0xe0, 0xa9, 0xfc, 0x12, 0xc1, 0x89, 0x58, 0x23, 0x8c, 0x4f, 0xf2, 0x00,
+	0x56, 0xf6, 0xff, 0xda, 0x29, 0x35, 0x52, 0x70, 0x6e, 0x32, 0xce, 0x97, 0xf5, 0x0b, 0xd3, 0x2b,
+	0xc1, 0xff, 0x05, 0x96, 0x6a, 0xe1, 0x57, 0x08, 0xe6, 0x15, 0x2d, 0x3a, 0x7e, 0x65, 0x7c, 0xf3,
+	0xce, 0xa5, 0xab, 0xec, 0xd5, 0xdd, 0xeb, 0x1f, 0x32, 0x09, 0x1b, 0xfa, 0xe5, 0xe9, 0x25, 0x7c,
+	0x38, 0xe8, 0x3c, 0x79, 0x4b, 0x16, 0xf3, 0x73, 0x04, 0x05, 0xa9, 0x9c, 0xc6, 0x4b, 0x63, 0xda,
+	0x6d, 0xad, 0x3c, 0x8a, 0x10, 0xe2, 0xd4, 0x99, 0x38, 0xef, 0xeb, 0xe7, 0x0f, 0x20, 0x8e, 0x65,
+	0xb7, 0xa9, 0x14, 0x5f, 0x20, 0x38, 0x1e, 0x29, 0xea, 0xf1, 0xf2, 0xd8, 0x0e, 0x46, 0xd3, 0x54,
+	0x28, 0x21, 0xcb, 0x35, 0x26, 0xcb, 0x07, 0xfa, 0xfb, 0x07, 0x93, 0xe5, 0xad, 0xae, 0x69, 0x0f,
+	0xa9, 0x40, 0x3f, 0x43, 0x30, 0x1b, 0xad, 0x9c, 0x31, 0x67, 0xab, 0xec, 0x02, 0xb4, 0x93, 0x4a,
+	0x5c, 0x34, 0xaa, 0xf4, 0x03, 0x44, 0x95, 0xf8, 0x4d, 0x9e, 0x4a, 0xf4, 0x4b, 0x04, 0x33, 0x72,
+	0xa9, 0x2c, 0xc2, 0x4a, 0x51, 0x9c, 0x6b, 0xcb, 0x0a, 0x8c, 0x3f, 0xe8, 0x65, 0xb2, 0x7c, 0xa4,
+	0x6f, 0x1e, 0x4c, 0x3f, 0x34, 0xde, 0x6a, 0xcf, 0x82, 0xf2, 0x9d, 0x0a, 0x68, 0x93, 0xa7, 0x54,
+	0xbc, 0x9f, 0x20, 0x98, 0x8d, 0x16, 0xbd, 0x42, 0x61, 0xca, 0xea, 0x5c, 0x3b, 0xa9, 0xc4, 0x09,
+	0x21, 0x2f, 0x32, 0x21, 0xcf, 0xe3, 0x77, 0xc7, 0xc6, 0x7e, 0xf0, 0x53, 0x68, 0xed, 0x99, 0xf8,
+	0x7c, 0x5e, 0x7b, 0x2c, 0xd8, 0x5e, 0x83, 0x34, 0x2b, 0x1c, 0xf1, 0xdc, 0x48, 0x79, 0xab, 0x61,
+	0x19, 0x14, 0x7d, 0x3f, 0xf5, 0x3c, 0xe5, 0xf6, 0x94, 0xa2, 0x2e, 0xa0, 0xb5, 0xb7, 0x11, 0xbe,
+	0x01, 0xf9, 0xa0, 0x0e, 0x14, 0xcf, 0x50, 0xbc, 0x7e, 0xd4, 0x16, 0xe3, 0x60, 0x41, 0x73, 0x8e,
+	0xd1, 0x2c, 0x60, 0x46, 0x93, 0x15, 0x88, 0xf8, 0x63, 0xc8, 0x8a, 0x7a, 0x4f, 0x3c, 0x37, 0xd1,
+	0x72, 0x51, 0x2b, 0x45, 0x81, 0x82, 0x50, 0x85, 0x11, 0xd2, 0xb4, 0x85, 0x80, 0x50, 0xed, 0x19,
+	0xfd, 0xaf, 0x4a, 0x0b, 0xbd, 0xe7, 0x54, 0xf1, 0x77, 0x01, 0xc2, 0x62, 0x0f, 0x4b, 0x02, 0x29,
+	0x5e, 0x19, 0x45, 0x55, 0x58, 0x66, 0x0c, 0xf0, 0x5a, 0x51, 0x62, 0xc0, 0x68, 0xe3, 0xdb, 0xe2,
+	0x67, 0x79, 0xae, 0x80, 0xc5, 0xb0, 0x22, 0x8a, 0x68, 0x60, 0x69, 0x04, 0xae, 0x52, 0x81, 0x49,
+	0xf1, 0x57, 0xde, 0xf8, 0xea, 0xe5, 0x0a, 0xfa, 0xcb, 0xcb, 0x15, 0xf4, 0xf7, 0x97, 0x2b, 0xe8,
+	0x17, 0x5f, 0xaf, 0x1c, 0x83, 0xe5, 0x96, 0xd3, 0xad, 0xd2, 0x3f, 0x3d, 0xab, 0x5a, 0xf6, 0x23,
+	0xd7, 0xac, 0x8a, 0xbf, 0x3a, 0x33, 0x7b, 0xd6, 0xc3, 0x0c, 0xfb, 0xbb, 0xb2, 0xff, 0xff, 0xcf,
+	0x00, 0x29, 0x28, 0xe4, 0x90, 0xa5, 0x26, 0x00, 0x00,
 }
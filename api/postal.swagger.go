@@ -0,0 +1,1745 @@
+// Code generated by scripts/generate.
+// source: api/postal.swagger.json
+// DO NOT EDIT!
+
+package api
+
+// SwaggerJSON is the OpenAPI spec of the Postal service's HTTP gateway.
+const SwaggerJSON = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "github.com/jive/postal/api/postal.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/v1/audit": {
+      "get": {
+        "summary": "Lists the audit entries of mutating rpcs in the order they were recorded",
+        "operationId": "AuditRange",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiAuditRangeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "start",
+            "description": "Only entries recorded at or after start, in unix nanoseconds.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "int64"
+          },
+          {
+            "name": "end",
+            "description": "Only entries recorded before end, in unix nanoseconds, all of them if unset.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "int64"
+          },
+          {
+            "name": "networkID",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "poolID",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "address",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "identity",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "method",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "size",
+            "description": "Maximum number of entries to return, all of them if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "continuationToken",
+            "description": "Token of the page to return, from a previous response.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks": {
+      "get": {
+        "summary": "Lists networks, or the network with the given ID",
+        "operationId": "NetworkRange",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiNetworkRangeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "ID",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "size",
+            "description": "Maximum number of networks to return, all of them if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "continuationToken",
+            "description": "Token of the page to return, from a previous response.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "selector",
+            "description": "Selector expression the networks must match, see postal.Selector.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      },
+      "post": {
+        "summary": "Creates a network for a block of addresses",
+        "operationId": "NetworkAdd",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiNetworkAddResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiNetworkAddRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{ID.networkID}/pools": {
+      "get": {
+        "summary": "Lists the pools of a network, or the pool with the given ID",
+        "operationId": "PoolRange",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiPoolRangeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "ID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "ID.ID",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "size",
+            "description": "Maximum number of pools to return, all of them if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "continuationToken",
+            "description": "Token of the page to return, from a previous response.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "selector",
+            "description": "Selector expression the pools must match, see postal.Selector.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{ID.networkID}/pools/{ID.ID}": {
+      "delete": {
+        "summary": "Removes a pool, releasing its addresses back to the network",
+        "operationId": "PoolRemove",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiPoolRemoveResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "ID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "ID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{ID}": {
+      "delete": {
+        "summary": "Removes a network and all of its pools",
+        "operationId": "NetworkRemove",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiNetworkRemoveResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{networkID}/addresses/{address}/history": {
+      "get": {
+        "summary": "Lists the bindings an address was bound by, oldest first",
+        "operationId": "AddressHistory",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiAddressHistoryResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "address",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "size",
+            "description": "Maximum number of bindings to return, all of them if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "continuationToken",
+            "description": "Token of the page to return, from a previous response.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{networkID}/bindings": {
+      "get": {
+        "summary": "Lists the bindings of all pools of a network",
+        "operationId": "BindingRange",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiBindingRangeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "size",
+            "description": "Maximum number of bindings to return, all of them if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "continuationToken",
+            "description": "Token of the page to return, from a previous response.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "selector",
+            "description": "Selector expression the bindings must match, see postal.Selector.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{networkID}/pools": {
+      "post": {
+        "summary": "Creates a pool in a network",
+        "operationId": "PoolAdd",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiPoolAddResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiPoolAddRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{poolID.networkID}/pools/{poolID.ID}/allocate": {
+      "post": {
+        "summary": "Allocates an address to a pool without binding it",
+        "operationId": "AllocateAddress",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiAllocateAddressResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "poolID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "poolID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiAllocateAddressRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{poolID.networkID}/pools/{poolID.ID}/bind": {
+      "post": {
+        "summary": "Binds the given address, or any available address of the pool",
+        "operationId": "BindAddress",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiBindAddressResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "poolID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "poolID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiBindAddressRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{poolID.networkID}/pools/{poolID.ID}/bind-many": {
+      "post": {
+        "summary": "Binds a number of available addresses of the pool, in a single transaction unless binding them all fails",
+        "operationId": "BindAddresses",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiBindAddressesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "poolID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "poolID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiBindAddressesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{poolID.networkID}/pools/{poolID.ID}/bindings/{bindingID}/renew": {
+      "post": {
+        "summary": "Extends the lease of a binding bound with a ttl",
+        "operationId": "RenewBinding",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiRenewBindingResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "poolID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "poolID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "bindingID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiRenewBindingRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{poolID.networkID}/pools/{poolID.ID}/bulk-allocate": {
+      "post": {
+        "summary": "Allocates every address of a cidr to a pool",
+        "operationId": "BulkAllocateAddress",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiBulkAllocateAddressResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "poolID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "poolID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiBulkAllocateAddressRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{poolID.networkID}/pools/{poolID.ID}/maximum": {
+      "put": {
+        "summary": "Changes the maximum number of addresses a pool may hold",
+        "operationId": "PoolSetMax",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiPoolSetMaxResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "poolID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "poolID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiPoolSetMaxRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/networks/{poolID.networkID}/pools/{poolID.ID}/release": {
+      "post": {
+        "summary": "Releases a binding, by ID or address",
+        "operationId": "ReleaseAddress",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiReleaseAddressResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "poolID.networkID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "poolID.ID",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiReleaseAddressRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/roles": {
+      "get": {
+        "summary": "Lists the roles stored on the server, or the role with the given name",
+        "operationId": "RoleRange",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiRoleRangeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/roles/{name}": {
+      "delete": {
+        "summary": "Deletes a stored role",
+        "operationId": "RoleRemove",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiRoleRemoveResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/roles/{role.name}": {
+      "put": {
+        "summary": "Creates or replaces a stored role",
+        "operationId": "RoleSet",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/apiRoleSetResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "role.name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiRoleSetRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    },
+    "/v1/watch": {
+      "post": {
+        "summary": "Streams changes to networks, pools and bindings",
+        "operationId": "Watch",
+        "responses": {
+          "200": {
+            "description": "(streaming responses)",
+            "schema": {
+              "$ref": "#/definitions/apiWatchResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiWatchRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Postal"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "BindAddressesRequestAnnotations": {
+      "type": "object",
+      "properties": {
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "PoolPoolID": {
+      "type": "object",
+      "properties": {
+        "networkID": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "string"
+        }
+      }
+    },
+    "PoolStrategy": {
+      "type": "string",
+      "enum": [
+        "LOWEST",
+        "RANDOM",
+        "LEAST_RECENTLY_RELEASED",
+        "STICKY"
+      ],
+      "default": "LOWEST",
+      "description": "- LOWEST: LOWEST reuses the binding with the lowest address\n - RANDOM: RANDOM reuses a released binding picked at random\n - LEAST_RECENTLY_RELEASED: LEAST_RECENTLY_RELEASED reuses the binding released longest ago, maximizing the time before an address is reused\n - STICKY: STICKY reuses the binding last held by the bind's affinity key, falling back to LOWEST",
+      "title": "Strategy of choosing which released binding BindAny reuses"
+    },
+    "RoleRule": {
+      "type": "object",
+      "properties": {
+        "methods": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Rpc methods the rule allows, such as BindAddress, or * for all of them"
+        },
+        "networks": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Networks the rule is scoped to, all networks if empty"
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Pools the rule is scoped to, all pools if empty"
+        }
+      },
+      "title": "Rule allows rpcs on a set of networks and pools"
+    },
+    "apiAddressHistoryRequest": {
+      "type": "object",
+      "properties": {
+        "networkID": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Maximum number of bindings to return, all of them if unset"
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the page to return, from a previous response"
+        }
+      }
+    },
+    "apiAddressHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "bindings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiBinding"
+          },
+          "title": "Bindings as they were released, or as they are while still bound"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the next page, empty once all bindings were returned"
+        }
+      }
+    },
+    "apiAllocateAddressRequest": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "address": {
+          "type": "string"
+        },
+        "idempotencyKey": {
+          "type": "string",
+          "title": "Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset"
+        }
+      }
+    },
+    "apiAllocateAddressResponse": {
+      "type": "object",
+      "properties": {
+        "binding": {
+          "$ref": "#/definitions/apiBinding"
+        }
+      }
+    },
+    "apiAuditEntry": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "int64",
+          "title": "Time the rpc returned, in unix nanoseconds"
+        },
+        "method": {
+          "type": "string",
+          "title": "Rpc method, such as BindAddress"
+        },
+        "identity": {
+          "type": "string",
+          "title": "Name of the caller, empty for anonymous callers"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "request": {
+          "type": "string",
+          "title": "Request of the rpc in JSON"
+        },
+        "code": {
+          "type": "string",
+          "title": "Status code of the rpc, OK if it succeeded"
+        },
+        "error": {
+          "type": "string",
+          "title": "Error of a failed rpc"
+        },
+        "revision": {
+          "type": "string",
+          "format": "int64",
+          "title": "Store revision of the last change the rpc made, unset if it made none"
+        },
+        "networkID": {
+          "type": "string",
+          "title": "Network and pool the rpc targeted or created"
+        },
+        "poolID": {
+          "type": "string"
+        },
+        "addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Addresses the rpc allocated, bound, renewed or released"
+        },
+        "replayed": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Set if the response of an earlier call with the same idempotency key was\nreplayed, the rpc changed nothing then"
+        }
+      },
+      "title": "AuditEntry records a call of a mutating rpc"
+    },
+    "apiAuditRangeRequest": {
+      "type": "object",
+      "properties": {
+        "start": {
+          "type": "string",
+          "format": "int64",
+          "title": "Only entries recorded at or after start, in unix nanoseconds"
+        },
+        "end": {
+          "type": "string",
+          "format": "int64",
+          "title": "Only entries recorded before end, in unix nanoseconds, all of them if unset"
+        },
+        "networkID": {
+          "type": "string"
+        },
+        "poolID": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        },
+        "identity": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Maximum number of entries to return, all of them if unset"
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the page to return, from a previous response"
+        }
+      }
+    },
+    "apiAuditRangeResponse": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiAuditEntry"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the next page, empty once all entries were returned"
+        }
+      }
+    },
+    "apiBindAddressRequest": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "address": {
+          "type": "string"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "ttl": {
+          "type": "string",
+          "format": "int64",
+          "title": "Optional lease ttl in seconds, the binding must be renewed with RenewBinding before it expires"
+        },
+        "ignoreQuarantine": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Bind quarantined bindings as well, forcing the reuse of recently released addresses"
+        },
+        "affinityKey": {
+          "type": "string",
+          "title": "Key the binding is recorded under, STICKY pools rebind the address last held by the same key"
+        },
+        "owner": {
+          "type": "string",
+          "description": "Identity of the owner, such as a host or workload ID. Binds are idempotent per owner: the binding\nthe owner holds in the pool is returned, or the owner's previous address bound again if it is free."
+        },
+        "idempotencyKey": {
+          "type": "string",
+          "title": "Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset"
+        }
+      }
+    },
+    "apiBindAddressResponse": {
+      "type": "object",
+      "properties": {
+        "binding": {
+          "$ref": "#/definitions/apiBinding"
+        }
+      }
+    },
+    "apiBindAddressesRequest": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "count": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Number of addresses to bind"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Annotations of every binding"
+        },
+        "bindingAnnotations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BindAddressesRequestAnnotations"
+          },
+          "title": "Annotations of each binding in turn, merged over annotations, there may be fewer than count"
+        },
+        "ttl": {
+          "type": "string",
+          "format": "int64",
+          "title": "Optional lease ttl in seconds of every binding, see BindAddressRequest.ttl"
+        },
+        "atomic": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Bind none of the addresses unless all of them can be bound. Otherwise the addresses are bound\none at a time if they cannot be bound together, and the failures reported in errors"
+        },
+        "ignoreQuarantine": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Bind quarantined bindings as well, forcing the reuse of recently released addresses"
+        },
+        "idempotencyKey": {
+          "type": "string",
+          "title": "Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset"
+        }
+      }
+    },
+    "apiBindAddressesResponse": {
+      "type": "object",
+      "properties": {
+        "bindings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiBinding"
+          }
+        },
+        "errors": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/apiError"
+          },
+          "title": "Errors of the bindings which failed, by their decimal index in the request"
+        }
+      }
+    },
+    "apiBinding": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "ID": {
+          "type": "string"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "address": {
+          "type": "string"
+        },
+        "allocateTime": {
+          "type": "string",
+          "format": "int64"
+        },
+        "bindTime": {
+          "type": "string",
+          "format": "int64"
+        },
+        "releaseTime": {
+          "type": "string",
+          "format": "int64"
+        },
+        "ttl": {
+          "type": "string",
+          "format": "int64",
+          "title": "Lease ttl in seconds, a binding with a ttl is released if it is not renewed in time"
+        },
+        "quarantineUntil": {
+          "type": "string",
+          "format": "int64",
+          "title": "Time until which a released binding is quarantined, in unix nanoseconds"
+        },
+        "affinityKey": {
+          "type": "string",
+          "title": "Affinity key of the last bind, see Pool.Strategy"
+        },
+        "owner": {
+          "type": "string",
+          "title": "Owner the binding was bound for, see BindAddressRequest.owner"
+        }
+      }
+    },
+    "apiBindingRangeRequest": {
+      "type": "object",
+      "properties": {
+        "networkID": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Maximum number of bindings to return, all of them if unset"
+        },
+        "filters": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the page to return, from a previous response"
+        },
+        "selector": {
+          "type": "string",
+          "title": "Selector expression the bindings must match, see postal.Selector"
+        }
+      }
+    },
+    "apiBindingRangeResponse": {
+      "type": "object",
+      "properties": {
+        "bindings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiBinding"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the next page, empty once all bindings were returned"
+        }
+      }
+    },
+    "apiBulkAllocateAddressRequest": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "cidr": {
+          "type": "string"
+        },
+        "idempotencyKey": {
+          "type": "string",
+          "title": "Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset"
+        }
+      }
+    },
+    "apiBulkAllocateAddressResponse": {
+      "type": "object",
+      "properties": {
+        "bindings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiBinding"
+          }
+        },
+        "errors": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/apiError"
+          }
+        }
+      }
+    },
+    "apiError": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "apiEvent": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "$ref": "#/definitions/apiEventType"
+        },
+        "network": {
+          "$ref": "#/definitions/apiNetwork",
+          "title": "Only the resource the event is about is set, deleted resources only carry their IDs"
+        },
+        "pool": {
+          "$ref": "#/definitions/apiPool"
+        },
+        "binding": {
+          "$ref": "#/definitions/apiBinding"
+        }
+      }
+    },
+    "apiEventType": {
+      "type": "string",
+      "enum": [
+        "CREATED",
+        "UPDATED",
+        "BOUND",
+        "RELEASED",
+        "EXPIRED",
+        "DELETED"
+      ],
+      "default": "CREATED",
+      "title": "- EXPIRED: EXPIRED bindings were released because their lease was not renewed"
+    },
+    "apiNetwork": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "type": "string"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "cidr": {
+          "type": "string"
+        }
+      }
+    },
+    "apiNetworkAddRequest": {
+      "type": "object",
+      "properties": {
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "cidr": {
+          "type": "string"
+        }
+      }
+    },
+    "apiNetworkAddResponse": {
+      "type": "object",
+      "properties": {
+        "network": {
+          "$ref": "#/definitions/apiNetwork"
+        }
+      }
+    },
+    "apiNetworkRangeRequest": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Maximum number of networks to return, all of them if unset"
+        },
+        "filters": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the page to return, from a previous response"
+        },
+        "selector": {
+          "type": "string",
+          "title": "Selector expression the networks must match, see postal.Selector"
+        }
+      }
+    },
+    "apiNetworkRangeResponse": {
+      "type": "object",
+      "properties": {
+        "networks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiNetwork"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the next page, empty once all networks were returned"
+        }
+      }
+    },
+    "apiNetworkRemoveRequest": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "type": "string"
+        },
+        "force": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Remove the network even if addresses are bound, hard releasing them"
+        }
+      }
+    },
+    "apiNetworkRemoveResponse": {
+      "type": "object"
+    },
+    "apiPool": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "maximumAddresses": {
+          "type": "string",
+          "format": "uint64",
+          "title": "The maximum number of addresses that the pool should allocate"
+        },
+        "type": {
+          "$ref": "#/definitions/apiPoolType"
+        },
+        "quarantine": {
+          "type": "string",
+          "format": "int64",
+          "title": "Seconds a released binding is kept from being bound again, unless the bind ignores quarantine"
+        },
+        "strategy": {
+          "$ref": "#/definitions/PoolStrategy"
+        }
+      }
+    },
+    "apiPoolAddRequest": {
+      "type": "object",
+      "properties": {
+        "networkID": {
+          "type": "string"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "maximum": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "type": {
+          "$ref": "#/definitions/apiPoolType"
+        },
+        "quarantine": {
+          "type": "string",
+          "format": "int64",
+          "title": "Seconds released bindings are quarantined for"
+        },
+        "strategy": {
+          "$ref": "#/definitions/PoolStrategy"
+        }
+      }
+    },
+    "apiPoolAddResponse": {
+      "type": "object",
+      "properties": {
+        "pool": {
+          "$ref": "#/definitions/apiPool"
+        }
+      }
+    },
+    "apiPoolRangeRequest": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Maximum number of pools to return, all of them if unset"
+        },
+        "filters": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the page to return, from a previous response"
+        },
+        "selector": {
+          "type": "string",
+          "title": "Selector expression the pools must match, see postal.Selector"
+        }
+      }
+    },
+    "apiPoolRangeResponse": {
+      "type": "object",
+      "properties": {
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiPool"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "continuationToken": {
+          "type": "string",
+          "title": "Token of the next page, empty once all pools were returned"
+        }
+      }
+    },
+    "apiPoolRemoveRequest": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "force": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Remove the pool even if addresses are bound, hard releasing them"
+        }
+      }
+    },
+    "apiPoolRemoveResponse": {
+      "type": "object"
+    },
+    "apiPoolSetMaxRequest": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "maximum": {
+          "type": "string",
+          "format": "uint64"
+        }
+      }
+    },
+    "apiPoolSetMaxResponse": {
+      "type": "object"
+    },
+    "apiPoolType": {
+      "type": "string",
+      "enum": [
+        "DYNAMIC",
+        "FIXED"
+      ],
+      "default": "DYNAMIC",
+      "description": "- DYNAMIC: DYNAMIC pool addresses are allocated reactively based on requests to the pool\n - FIXED: FIXED pool type indicates that the maximum number of addresses is allocated on the pool's initial creation",
+      "title": "Base identity for types of address pools that are supported by the IPAM module"
+    },
+    "apiReleaseAddressRequest": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "bindingID": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        },
+        "hard": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "idempotencyKey": {
+          "type": "string",
+          "title": "Retries with the same key get the original response instead of repeating the request, the idempotency-key metadata is used if unset"
+        }
+      }
+    },
+    "apiReleaseAddressResponse": {
+      "type": "object",
+      "properties": {
+        "binding": {
+          "$ref": "#/definitions/apiBinding",
+          "title": "The binding as it was before it was released"
+        }
+      }
+    },
+    "apiRenewBindingRequest": {
+      "type": "object",
+      "properties": {
+        "poolID": {
+          "$ref": "#/definitions/PoolPoolID"
+        },
+        "bindingID": {
+          "type": "string"
+        }
+      }
+    },
+    "apiRenewBindingResponse": {
+      "type": "object",
+      "properties": {
+        "binding": {
+          "$ref": "#/definitions/apiBinding"
+        }
+      }
+    },
+    "apiRole": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Names of the callers the role is bound to"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Groups of the callers the role is bound to"
+        },
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RoleRule"
+          }
+        }
+      },
+      "title": "Role grants the rpcs of its rules to the callers it is bound to"
+    },
+    "apiRoleRangeRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "apiRoleRangeResponse": {
+      "type": "object",
+      "properties": {
+        "roles": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiRole"
+          }
+        }
+      }
+    },
+    "apiRoleRemoveRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "apiRoleRemoveResponse": {
+      "type": "object"
+    },
+    "apiRoleSetRequest": {
+      "type": "object",
+      "properties": {
+        "role": {
+          "$ref": "#/definitions/apiRole"
+        }
+      }
+    },
+    "apiRoleSetResponse": {
+      "type": "object",
+      "properties": {
+        "role": {
+          "$ref": "#/definitions/apiRole"
+        }
+      }
+    },
+    "apiWatchRequest": {
+      "type": "object",
+      "properties": {
+        "filters": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "revision": {
+          "type": "string",
+          "format": "int64",
+          "title": "Revision to start watching at, the watch starts at the current revision if unset"
+        },
+        "selector": {
+          "type": "string",
+          "title": "Selector expression the changed resources must match, see postal.Selector"
+        }
+      }
+    },
+    "apiWatchResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiEvent"
+          }
+        },
+        "revision": {
+          "type": "string",
+          "format": "int64",
+          "title": "Revision of the events, the first response of a watch without a revision\ncarries no events and the revision the watch started after"
+        }
+      }
+    }
+  }
+}
+`
@@ -27,6 +27,7 @@ import (
 )
 
 var allocateBulk bool
+var allocateIdempotencyKey string
 
 // allocateCmd represents the allocate command
 var allocateCmd = &cobra.Command{
@@ -52,6 +53,7 @@ func allocateSingleFn(cmd *cobra.Command, args []string) error {
 			NetworkID: args[0],
 			ID:        args[1],
 		},
+		IdempotencyKey: allocateIdempotencyKey,
 	}
 
 	if len(args) == 3 {
@@ -78,7 +80,8 @@ func allocateBulkFn(cmd *cobra.Command, args []string) error {
 			NetworkID: args[0],
 			ID:        args[1],
 		},
-		Cidr: args[2],
+		Cidr:           args[2],
+		IdempotencyKey: allocateIdempotencyKey,
 	}
 
 	resp, err := mustClientFromCmd(cmd).BulkAllocateAddress(context.TODO(), req)
@@ -107,4 +110,5 @@ func init() {
 	PostalCmd.AddCommand(allocateCmd)
 
 	allocateCmd.Flags().BoolVarP(&allocateBulk, "bulk", "b", false, "use cidr block instead of an IP")
+	allocateCmd.Flags().StringVar(&allocateIdempotencyKey, "idempotency-key", "", "retries with the same key get the original response instead of allocating again")
 }
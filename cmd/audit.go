@@ -0,0 +1,114 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var auditSince time.Duration
+var auditStart string
+var auditEnd string
+var auditPageSize int32
+var auditFilter api.AuditRangeRequest
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "view the audit log of mutating requests",
+	Long: `postal audit [--since <duration> | --start <time>] [--end <time>] [filters]
+
+Times are given in RFC 3339 format, e.g. 2016-10-01T12:00:00Z. For example, to
+see who held an address over the last week:
+
+  postal audit --since 168h --address 203.0.113.7`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return errors.New("audit takes no arguments, filter with flags")
+		}
+		if auditSince > 0 && len(auditStart) > 0 {
+			return errors.New("--since and --start are mutually exclusive")
+		}
+
+		req := auditFilter
+		if auditSince > 0 {
+			req.Start = time.Now().Add(-auditSince).UnixNano()
+		}
+		var err error
+		if len(auditStart) > 0 {
+			req.Start, err = parseAuditTime(auditStart)
+			if err != nil {
+				return errors.Wrap(err, "failed to parse --start")
+			}
+		}
+		if len(auditEnd) > 0 {
+			req.End, err = parseAuditTime(auditEnd)
+			if err != nil {
+				return errors.Wrap(err, "failed to parse --end")
+			}
+		}
+
+		client := mustClientFromCmd(cmd)
+		req.Size_ = auditPageSize
+		resp := &api.AuditRangeResponse{}
+		for {
+			page, err := client.AuditRange(context.TODO(), &req)
+			if err != nil {
+				return errors.Wrap(err, "failed to complete audit range request")
+			}
+
+			resp.Entries = append(resp.Entries, page.Entries...)
+			if len(page.ContinuationToken) == 0 {
+				break
+			}
+			req.ContinuationToken = page.ContinuationToken
+		}
+
+		resp.Size_ = int32(len(resp.Entries))
+		display.AuditRange(resp)
+		return nil
+	},
+}
+
+// parseAuditTime returns an RFC 3339 time in unix nanoseconds.
+func parseAuditTime(s string) (int64, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
+
+func init() {
+	PostalCmd.AddCommand(auditCmd)
+
+	auditCmd.Flags().DurationVar(&auditSince, "since", 0, "only show entries recorded within the duration")
+	auditCmd.Flags().StringVar(&auditStart, "start", "", "only show entries recorded at or after the time")
+	auditCmd.Flags().StringVar(&auditEnd, "end", "", "only show entries recorded before the time")
+	auditCmd.Flags().StringVar(&auditFilter.NetworkID, "network", "", "only show entries of the network")
+	auditCmd.Flags().StringVar(&auditFilter.PoolID, "pool", "", "only show entries of the pool")
+	auditCmd.Flags().StringVar(&auditFilter.Address, "address", "", "only show entries of the address")
+	auditCmd.Flags().StringVar(&auditFilter.Identity, "identity", "", "only show entries of the caller")
+	auditCmd.Flags().StringVar(&auditFilter.Method, "method", "", "only show entries of the rpc method, e.g. BindAddress")
+	auditCmd.Flags().Int32Var(&auditPageSize, "page-size", 500, "number of entries fetched per request")
+}
@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -30,7 +31,8 @@ import (
 var bindCmd = &cobra.Command{
 	Use:   "bind",
 	Short: "bind an address in a pool",
-	Long:  `postal bind <networkID> <poolID> (<optional_address>)`,
+	Long: `postal bind <networkID> <poolID> (<optional_address>)
+postal bind <networkID> <poolID> --count <n> [--atomic]`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 2 {
 			return fmt.Errorf("invalid arguments")
@@ -42,18 +44,56 @@ var bindCmd = &cobra.Command{
 		}
 		annotations := parseAnnotations(annotationsVars)
 
+		ttl, err := cmd.Flags().GetDuration("ttl")
+		if err != nil {
+			return errors.Wrap(err, "failed to parse --ttl flag")
+		}
+		// leases are granted in whole seconds, a shorter ttl would leave the binding unleased
+		if ttl < 0 || (ttl > 0 && ttl < time.Second) {
+			return fmt.Errorf("--ttl must be at least a second")
+		}
+
 		req := &api.BindAddressRequest{
 			PoolID: &api.Pool_PoolID{
 				NetworkID: args[0],
 				ID:        args[1],
 			},
 			Annotations: annotations,
+			Ttl:         int64(ttl.Seconds()),
+		}
+
+		req.AffinityKey, err = cmd.Flags().GetString("affinity-key")
+		if err != nil {
+			return err
+		}
+
+		req.Owner, err = cmd.Flags().GetString("owner")
+		if err != nil {
+			return err
+		}
+
+		req.IdempotencyKey, err = cmd.Flags().GetString("idempotency-key")
+		if err != nil {
+			return err
+		}
+
+		req.IgnoreQuarantine, err = cmd.Flags().GetBool("ignore-quarantine")
+		if err != nil {
+			return err
 		}
 
 		if len(args) == 3 {
 			req.Address = args[2]
 		}
 
+		count, err := cmd.Flags().GetInt32("count")
+		if err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("count") {
+			return bindManyFn(cmd, req, count)
+		}
+
 		resp, err := mustClientFromCmd(cmd).BindAddress(context.TODO(), req)
 		if err != nil {
 			return errors.Wrap(err, "bind rpc failed")
@@ -65,8 +105,49 @@ var bindCmd = &cobra.Command{
 	},
 }
 
+// bindManyFn binds count addresses with the settings of req.
+func bindManyFn(cmd *cobra.Command, req *api.BindAddressRequest, count int32) error {
+	switch {
+	case count < 1:
+		return fmt.Errorf("--count must be positive")
+	case len(req.Address) > 0:
+		return fmt.Errorf("an address cannot be given with --count")
+	case len(req.Owner) > 0 || len(req.AffinityKey) > 0:
+		return fmt.Errorf("--owner and --affinity-key cannot be used with --count")
+	}
+
+	atomic, err := cmd.Flags().GetBool("atomic")
+	if err != nil {
+		return err
+	}
+
+	resp, err := mustClientFromCmd(cmd).BindAddresses(context.TODO(), &api.BindAddressesRequest{
+		PoolID:           req.PoolID,
+		Count:            count,
+		Annotations:      req.Annotations,
+		Ttl:              req.Ttl,
+		Atomic:           atomic,
+		IgnoreQuarantine: req.IgnoreQuarantine,
+		IdempotencyKey:   req.IdempotencyKey,
+	})
+	if err != nil {
+		return errors.Wrap(err, "bind rpc failed")
+	}
+
+	display.BindAddresses(resp)
+
+	return nil
+}
+
 func init() {
 	PostalCmd.AddCommand(bindCmd)
 
 	bindCmd.Flags().StringSliceP("annotation", "a", []string{}, "key=value pair of data to annotate the binding with")
+	bindCmd.Flags().Duration("ttl", 0, "lease the binding for this long, it must be renewed with 'postal renew' before it expires")
+	bindCmd.Flags().String("owner", "", "host or workload the binding is for, binding again for the same owner returns its address")
+	bindCmd.Flags().String("affinity-key", "", "key to record the binding under, sticky pools bind the address last held by the key again")
+	bindCmd.Flags().String("idempotency-key", "", "retries with the same key get the original response instead of binding again")
+	bindCmd.Flags().Bool("ignore-quarantine", false, "bind an address even if it was released too recently to be reused")
+	bindCmd.Flags().Int32("count", 1, "number of addresses to bind in one request")
+	bindCmd.Flags().Bool("atomic", false, "with --count, bind none of the addresses unless all of them can be bound")
 }
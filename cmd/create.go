@@ -17,12 +17,14 @@ limitations under the License.
 package cmd
 
 import (
+	"io/ioutil"
 	"net"
 	"strconv"
 	"strings"
 
 	"golang.org/x/net/context"
 
+	"github.com/ghodss/yaml"
 	"github.com/jive/postal/api"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -111,11 +113,37 @@ var createPoolCmd = &cobra.Command{
 			ExitWithError(ExitBadArgs, errors.New("pool type must be 'dynamic' or 'fixed'"))
 		}
 
+		strategyStr, err := cmd.Flags().GetString("strategy")
+		if err != nil {
+			return err
+		}
+
+		var strategy api.Pool_Strategy
+		switch strategyStr {
+		case "lowest":
+			strategy = api.Pool_LOWEST
+		case "random":
+			strategy = api.Pool_RANDOM
+		case "lru":
+			strategy = api.Pool_LEAST_RECENTLY_RELEASED
+		case "sticky":
+			strategy = api.Pool_STICKY
+		default:
+			ExitWithError(ExitBadArgs, errors.New("pool strategy must be 'lowest', 'random', 'lru' or 'sticky'"))
+		}
+
+		quarantine, err := cmd.Flags().GetDuration("quarantine")
+		if err != nil {
+			return errors.Wrap(err, "failed to parse --quarantine flag")
+		}
+
 		resp, err := mustClientFromCmd(cmd).PoolAdd(context.TODO(), &api.PoolAddRequest{
 			NetworkID:   networkID,
 			Annotations: annotations,
 			Maximum:     max,
 			Type:        poolType,
+			Quarantine:  int64(quarantine.Seconds()),
+			Strategy:    strategy,
 		})
 		if err != nil {
 			return err
@@ -127,13 +155,69 @@ var createPoolCmd = &cobra.Command{
 	},
 }
 
+var createRoleCmd = &cobra.Command{
+	Use:   "role",
+	Short: "create or replace a role stored on the server",
+	Long: `postal create role -f <file>
+
+The role is read from a yaml or json file, a role stored under the same name is
+replaced. For example, to let the members of the proxies group bind and release
+addresses of one pool:
+
+  name: proxy
+  groups: [proxies]
+  rules:
+  - methods: [BindAddress, ReleaseAddress, RenewBinding]
+    pools: [<poolID>]
+
+Stored roles are only checked by servers started with --rbac-stored-roles. The
+first roles must be stored by a caller granted RoleSet in --rbac-policy-file.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return errors.New("the role must be given with -f")
+		}
+
+		file, err := cmd.Flags().GetString("filename")
+		if err != nil {
+			return err
+		}
+		if len(file) == 0 {
+			return errors.New("the role must be given with -f")
+		}
+
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return errors.Wrap(err, "failed to read role file")
+		}
+
+		role := &api.Role{}
+		err = yaml.Unmarshal(data, role)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse role file")
+		}
+
+		resp, err := mustClientFromCmd(cmd).RoleSet(context.TODO(), &api.RoleSetRequest{Role: role})
+		if err != nil {
+			return err
+		}
+
+		display.RoleSet(resp)
+		return nil
+	},
+}
+
 func init() {
 	PostalCmd.AddCommand(createCmd)
 	createCmd.AddCommand(createPoolCmd)
 	createCmd.AddCommand(createNetworkCmd)
+	createCmd.AddCommand(createRoleCmd)
 
 	createNetworkCmd.Flags().StringSliceP("annotation", "a", []string{}, "key=value pair of data to annotate the network with")
 
 	createPoolCmd.Flags().StringSliceP("annotation", "a", []string{}, "key=value pair of data to annotate the pool with")
 	createPoolCmd.Flags().StringP("type", "t", "fixed", "pool type (dynamic, fixed)")
+	createPoolCmd.Flags().String("strategy", "lowest", "which released address to bind again (lowest, random, lru, sticky)")
+	createPoolCmd.Flags().Duration("quarantine", 0, "keep released addresses from being bound again for this long")
+
+	createRoleCmd.Flags().StringP("filename", "f", "", "yaml or json file of the role")
 }
@@ -0,0 +1,126 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// deleteCmd represents the delete command
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "delete a resource",
+	Long:  ``,
+}
+
+var deleteNetworkCmd = &cobra.Command{
+	Use:   "network",
+	Short: "delete a network",
+	Long: `postal delete network <networkID>
+
+The network is not deleted while any of its addresses are bound, unless --force
+is given. Forcing the delete hard releases every binding of every pool.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("<networkID> must be the only argument")
+		}
+
+		force, err := cmd.Flags().GetBool("force")
+		if err != nil {
+			return errors.Wrap(err, "failed to parse --force flag")
+		}
+
+		resp, err := mustClientFromCmd(cmd).NetworkRemove(context.TODO(), &api.NetworkRemoveRequest{
+			ID:    args[0],
+			Force: force,
+		})
+		if err != nil {
+			return errors.Wrap(err, "network remove rpc failed")
+		}
+
+		display.NetworkRemove(resp)
+		return nil
+	},
+}
+
+var deletePoolCmd = &cobra.Command{
+	Use:   "pool",
+	Short: "delete a pool",
+	Long: `postal delete pool <networkID> <poolID>
+
+The pool is not deleted while any of its addresses are bound, unless --force
+is given. Its addresses are released back to the network.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return errors.New("<networkID> <poolID> must be the only 2 arguments")
+		}
+
+		force, err := cmd.Flags().GetBool("force")
+		if err != nil {
+			return errors.Wrap(err, "failed to parse --force flag")
+		}
+
+		resp, err := mustClientFromCmd(cmd).PoolRemove(context.TODO(), &api.PoolRemoveRequest{
+			ID: &api.Pool_PoolID{
+				NetworkID: args[0],
+				ID:        args[1],
+			},
+			Force: force,
+		})
+		if err != nil {
+			return errors.Wrap(err, "pool remove rpc failed")
+		}
+
+		display.PoolRemove(resp)
+		return nil
+	},
+}
+
+var deleteRoleCmd = &cobra.Command{
+	Use:   "role",
+	Short: "delete a role stored on the server",
+	Long:  `postal delete role <name>`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("<name> must be the only argument")
+		}
+
+		resp, err := mustClientFromCmd(cmd).RoleRemove(context.TODO(), &api.RoleRemoveRequest{
+			Name: args[0],
+		})
+		if err != nil {
+			return errors.Wrap(err, "role remove rpc failed")
+		}
+
+		display.RoleRemove(resp)
+		return nil
+	},
+}
+
+func init() {
+	PostalCmd.AddCommand(deleteCmd)
+	deleteCmd.AddCommand(deleteNetworkCmd)
+	deleteCmd.AddCommand(deletePoolCmd)
+	deleteCmd.AddCommand(deleteRoleCmd)
+
+	deleteNetworkCmd.Flags().Bool("force", false, "hard release bound addresses and delete anyway")
+	deletePoolCmd.Flags().Bool("force", false, "hard release bound addresses and delete anyway")
+}
@@ -3,6 +3,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -14,6 +18,13 @@ const (
 	ExitBadFeature   // provided a valid flag with an unsupported value
 	ExitInterrupted
 	ExitIO
+	ExitNotFound
+	ExitAlreadyExists
+	ExitFailedPrecondition
+	ExitExhausted
+	ExitConflict
+	ExitPermissionDenied
+	ExitUnauthenticated
 	ExitBadArgs = 128
 )
 
@@ -21,3 +32,29 @@ func ExitWithError(code int, err error) {
 	fmt.Fprintln(os.Stderr, "Error: ", err)
 	os.Exit(code)
 }
+
+// rpcExitCodes maps the status codes of failed rpcs to exit codes, all other
+// codes exit with ExitError.
+var rpcExitCodes = map[codes.Code]int{
+	codes.InvalidArgument:  ExitInvalidInput,
+	codes.OutOfRange:       ExitInvalidInput,
+	codes.Unavailable:      ExitBadConnection,
+	codes.DeadlineExceeded: ExitBadConnection,
+	codes.Canceled:         ExitInterrupted,
+
+	codes.NotFound:           ExitNotFound,
+	codes.AlreadyExists:      ExitAlreadyExists,
+	codes.FailedPrecondition: ExitFailedPrecondition,
+	codes.ResourceExhausted:  ExitExhausted,
+	codes.Aborted:            ExitConflict,
+	codes.PermissionDenied:   ExitPermissionDenied,
+	codes.Unauthenticated:    ExitUnauthenticated,
+}
+
+// exitCodeOf returns the exit code for a command which failed with err.
+func exitCodeOf(err error) int {
+	if code, ok := rpcExitCodes[grpc.Code(errors.Cause(err))]; ok {
+		return code
+	}
+	return ExitError
+}
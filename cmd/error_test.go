@@ -0,0 +1,47 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestExitCodeOf(t *testing.T) {
+	assert := assert.New(t)
+
+	rpcErr := func(code codes.Code) error {
+		return errors.Wrap(grpc.Errorf(code, "failed"), "failed to complete request")
+	}
+
+	assert.Equal(ExitInvalidInput, exitCodeOf(rpcErr(codes.InvalidArgument)))
+	assert.Equal(ExitBadConnection, exitCodeOf(rpcErr(codes.Unavailable)))
+	assert.Equal(ExitInterrupted, exitCodeOf(rpcErr(codes.Canceled)))
+	assert.Equal(ExitNotFound, exitCodeOf(rpcErr(codes.NotFound)))
+	assert.Equal(ExitAlreadyExists, exitCodeOf(rpcErr(codes.AlreadyExists)))
+	assert.Equal(ExitFailedPrecondition, exitCodeOf(rpcErr(codes.FailedPrecondition)))
+	assert.Equal(ExitExhausted, exitCodeOf(rpcErr(codes.ResourceExhausted)))
+	assert.Equal(ExitConflict, exitCodeOf(rpcErr(codes.Aborted)))
+	assert.Equal(ExitPermissionDenied, exitCodeOf(rpcErr(codes.PermissionDenied)))
+	assert.Equal(ExitUnauthenticated, exitCodeOf(rpcErr(codes.Unauthenticated)))
+	assert.Equal(ExitError, exitCodeOf(rpcErr(codes.Internal)))
+	assert.Equal(ExitError, exitCodeOf(errors.New("unknown command")))
+}
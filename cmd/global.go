@@ -14,6 +14,7 @@ import (
 	"github.com/coreos/etcd/pkg/flags"
 	"github.com/jive/postal/api"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
 // GlobalFlags are flags that defined globally
@@ -28,6 +29,7 @@ type GlobalFlags struct {
 	CertFile string
 	KeyFile  string
 	CAFile   string
+	Token    string
 
 	OutputFormat string
 }
@@ -36,6 +38,7 @@ type secureCfg struct {
 	cert   string
 	key    string
 	cacert string
+	token  string
 
 	insecureTransport  bool
 	insecureSkipVerify bool
@@ -59,20 +62,40 @@ func mustClientFromCmd(cmd *cobra.Command) api.PostalClient {
 }
 
 func mustClient(endpoint string, dialTimeout time.Duration, scfg *secureCfg) api.PostalClient {
-	ops := []grpc.DialOption{grpc.WithTimeout(dialTimeout)}
+	ops := append(mustDialOptions(scfg), grpc.WithTimeout(dialTimeout))
+	conn, err := grpc.Dial(endpoint, ops...)
+	if err != nil {
+		ExitWithError(ExitBadConnection, err)
+	}
+	return api.NewPostalClient(conn)
+}
+
+// mustDialOptions returns the transport and credential options to dial a postal
+// server with.
+func mustDialOptions(scfg *secureCfg) []grpc.DialOption {
+	ops := []grpc.DialOption{}
 	if scfg.insecureTransport {
 		ops = append(ops, grpc.WithInsecure())
 	} else {
-
 		ops = append(ops, grpc.WithTransportCredentials(credentials.NewTLS(
 			mustBuildTLSConfig(scfg),
 		)))
 	}
-	conn, err := grpc.Dial(endpoint, ops...)
-	if err != nil {
-		ExitWithError(ExitBadConnection, err)
+	if scfg.token != "" {
+		ops = append(ops, grpc.WithPerRPCCredentials(bearerToken(scfg.token)))
 	}
-	return api.NewPostalClient(conn)
+	return ops
+}
+
+// bearerToken sends a token as authorization metadata with every rpc.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
 }
 
 func initDisplayFromCmd(cmd *cobra.Command) {
@@ -80,8 +103,8 @@ func initDisplayFromCmd(cmd *cobra.Command) {
 	if err != nil {
 		ExitWithError(ExitError, err)
 	}
-	if display = NewPrinter(outputType); display == nil {
-		ExitWithError(ExitBadFeature, errors.New("unsupported output format"))
+	if display, err = NewPrinter(outputType); err != nil {
+		ExitWithError(ExitBadFeature, err)
 	}
 }
 
@@ -97,11 +120,16 @@ func secureCfgFromCmd(cmd *cobra.Command) *secureCfg {
 	cert, key, cacert := keyAndCertFromCmd(cmd)
 	insecureTr := insecureTransportFromCmd(cmd)
 	skipVerify := insecureSkipVerifyFromCmd(cmd)
+	token, err := cmd.Flags().GetString("token")
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
 
 	return &secureCfg{
 		cert:   cert,
 		key:    key,
 		cacert: cacert,
+		token:  token,
 
 		insecureTransport:  insecureTr,
 		insecureSkipVerify: skipVerify,
@@ -0,0 +1,78 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"net"
+
+	"golang.org/x/net/context"
+
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var historyPageSize int32
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "view the bindings an address was bound by",
+	Long: `postal history <networkID> <address>
+
+Lists every binding of the address oldest first, including released bindings
+which are no longer shown by 'postal range bindings'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return errors.New("<networkID> <address> must be the only 2 arguments")
+		}
+
+		if net.ParseIP(args[1]) == nil {
+			return errors.Errorf("invalid address %q", args[1])
+		}
+
+		client := mustClientFromCmd(cmd)
+		req := &api.AddressHistoryRequest{
+			NetworkID: args[0],
+			Address:   args[1],
+			Size_:     historyPageSize,
+		}
+		resp := &api.AddressHistoryResponse{}
+		for {
+			page, err := client.AddressHistory(context.TODO(), req)
+			if err != nil {
+				return errors.Wrap(err, "failed to complete address history request")
+			}
+
+			resp.Bindings = append(resp.Bindings, page.Bindings...)
+			if len(page.ContinuationToken) == 0 {
+				break
+			}
+			req.ContinuationToken = page.ContinuationToken
+		}
+
+		resp.Size_ = int32(len(resp.Bindings))
+		display.AddressHistory(resp)
+		return nil
+	},
+}
+
+func init() {
+	PostalCmd.AddCommand(historyCmd)
+
+	historyCmd.Flags().Int32Var(&historyPageSize, "page-size", 500, "number of bindings fetched per request")
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// jsonPath is a compiled JSONPath template in the style of kubectl. Text outside
+// of braces is written as is, the braces hold one of
+//
+//	{.a.b[0]}           the values at a path, separated by spaces
+//	{.a[*].b}           [*] and .* select all elements or fields
+//	{range .a[*]}...{end}  the enclosed template once per value
+//	{"\n"}              a quoted string
+//
+// Paths are relative to the value of the enclosing range, or the document if
+// there is none. Paths starting with $ are always relative to the document.
+type jsonPath struct {
+	nodes []jsonPathNode
+}
+
+type jsonPathNode struct {
+	text  string
+	path  []pathSegment
+	isExp bool
+	// body is set for range nodes
+	body []jsonPathNode
+}
+
+// pathSegment selects a field, an index, or everything if both are unset.
+type pathSegment struct {
+	field string
+	index int
+	isIdx bool
+}
+
+func parseJSONPath(tmpl string) (*jsonPath, error) {
+	p := &jsonPathParser{s: tmpl}
+	nodes, end, err := p.nodes()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid jsonpath '%s'", tmpl)
+	}
+	if end {
+		return nil, errors.Errorf("invalid jsonpath '%s': {end} without {range}", tmpl)
+	}
+	return &jsonPath{nodes: nodes}, nil
+}
+
+// Execute writes the template for the JSON document data.
+func (jp *jsonPath) Execute(w io.Writer, data interface{}) error {
+	return executeNodes(w, jp.nodes, data, data)
+}
+
+func executeNodes(w io.Writer, nodes []jsonPathNode, root, current interface{}) error {
+	for _, node := range nodes {
+		if !node.isExp {
+			io.WriteString(w, node.text)
+			continue
+		}
+
+		start := current
+		if strings.HasPrefix(node.text, "$") {
+			start = root
+		}
+		values, err := evalPath(node.path, []interface{}{start})
+		if err != nil {
+			return errors.Wrapf(err, "failed to evaluate '%s'", node.text)
+		}
+
+		if node.body != nil {
+			for _, val := range values {
+				if err := executeNodes(w, node.body, root, val); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for idx, val := range values {
+			if idx > 0 {
+				io.WriteString(w, " ")
+			}
+			if err := writeValue(w, val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func evalPath(path []pathSegment, values []interface{}) ([]interface{}, error) {
+	for _, seg := range path {
+		next := []interface{}{}
+		for _, val := range values {
+			switch v := val.(type) {
+			case map[string]interface{}:
+				if seg.isIdx {
+					return nil, errors.Errorf("cannot index object with [%d]", seg.index)
+				}
+				if len(seg.field) == 0 {
+					keys := []string{}
+					for key := range v {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					for _, key := range keys {
+						next = append(next, v[key])
+					}
+					continue
+				}
+				field, ok := v[seg.field]
+				if !ok {
+					return nil, errors.Errorf("field '%s' not found", seg.field)
+				}
+				next = append(next, field)
+			case []interface{}:
+				switch {
+				case seg.isIdx:
+					idx := seg.index
+					if idx < 0 {
+						idx += len(v)
+					}
+					if idx < 0 || idx >= len(v) {
+						return nil, errors.Errorf("index [%d] out of range", seg.index)
+					}
+					next = append(next, v[idx])
+				case len(seg.field) == 0:
+					next = append(next, v...)
+				default:
+					return nil, errors.Errorf("cannot select field '%s' of a list", seg.field)
+				}
+			case nil:
+				// unset messages have no fields
+			default:
+				return nil, errors.Errorf("cannot select into %v", val)
+			}
+		}
+		values = next
+	}
+	return values, nil
+}
+
+// writeValue writes strings as is and everything else as JSON.
+func writeValue(w io.Writer, val interface{}) error {
+	if s, ok := val.(string); ok {
+		_, err := io.WriteString(w, s)
+		return err
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+type jsonPathParser struct {
+	s   string
+	pos int
+}
+
+// nodes parses until the end of the template or an {end}, which it reports.
+func (p *jsonPathParser) nodes() ([]jsonPathNode, bool, error) {
+	nodes := []jsonPathNode{}
+	for p.pos < len(p.s) {
+		open := strings.IndexByte(p.s[p.pos:], '{')
+		if open < 0 {
+			nodes = append(nodes, jsonPathNode{text: p.s[p.pos:]})
+			break
+		}
+		if open > 0 {
+			nodes = append(nodes, jsonPathNode{text: p.s[p.pos : p.pos+open]})
+		}
+		p.pos += open + 1
+
+		expr, err := p.expression()
+		if err != nil {
+			return nil, false, err
+		}
+
+		switch {
+		case expr == "end":
+			return nodes, true, nil
+		case strings.HasPrefix(expr, "range ") || strings.HasPrefix(expr, "range\t"):
+			expr = strings.TrimSpace(expr[len("range"):])
+			path, err := parsePath(expr)
+			if err != nil {
+				return nil, false, err
+			}
+			body, end, err := p.nodes()
+			if err != nil {
+				return nil, false, err
+			}
+			if !end {
+				return nil, false, errors.Errorf("{range %s} without {end}", expr)
+			}
+			nodes = append(nodes, jsonPathNode{text: expr, path: path, isExp: true, body: body})
+		case strings.HasPrefix(expr, "\""):
+			text, err := strconv.Unquote(expr)
+			if err != nil {
+				return nil, false, errors.Errorf("invalid string %s", expr)
+			}
+			nodes = append(nodes, jsonPathNode{text: text})
+		default:
+			path, err := parsePath(expr)
+			if err != nil {
+				return nil, false, err
+			}
+			nodes = append(nodes, jsonPathNode{text: expr, path: path, isExp: true})
+		}
+	}
+	return nodes, false, nil
+}
+
+// expression reads up to the closing brace, skipping over quoted strings.
+func (p *jsonPathParser) expression() (string, error) {
+	start := p.pos
+	quote := byte(0)
+	for ; p.pos < len(p.s); p.pos++ {
+		c := p.s[p.pos]
+		switch {
+		case quote != 0 && c == '\\':
+			p.pos++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '}':
+			expr := strings.TrimSpace(p.s[start:p.pos])
+			p.pos++
+			return expr, nil
+		}
+	}
+	return "", errors.Errorf("unclosed '{' at position %d", start-1)
+}
+
+func parsePath(expr string) ([]pathSegment, error) {
+	s := expr
+	if strings.HasPrefix(s, "$") || strings.HasPrefix(s, "@") {
+		s = s[1:]
+	}
+	if len(s) == 0 || s == "." {
+		return []pathSegment{}, nil
+	}
+
+	path := []pathSegment{}
+	for len(s) > 0 {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			name := s[:end]
+			s = s[end:]
+			switch name {
+			case "":
+				return nil, errors.Errorf("empty field in '%s'", expr)
+			case "*":
+				path = append(path, pathSegment{})
+			default:
+				path = append(path, pathSegment{field: name})
+			}
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, errors.Errorf("unclosed '[' in '%s'", expr)
+			}
+			sel := s[1:end]
+			s = s[end+1:]
+			switch {
+			case sel == "*":
+				path = append(path, pathSegment{})
+			case len(sel) > 1 && (sel[0] == '\'' || sel[0] == '"') && sel[len(sel)-1] == sel[0]:
+				path = append(path, pathSegment{field: sel[1 : len(sel)-1]})
+			default:
+				idx, err := strconv.Atoi(sel)
+				if err != nil {
+					return nil, errors.Errorf("invalid index [%s] in '%s'", sel, expr)
+				}
+				path = append(path, pathSegment{index: idx, isIdx: true})
+			}
+		default:
+			return nil, errors.Errorf("unexpected '%c' in '%s'", s[0], expr)
+		}
+	}
+	return path, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONPath(t *testing.T) {
+	assert := assert.New(t)
+
+	var doc interface{}
+	assert.NoError(json.Unmarshal([]byte(`{
+		"size": 2,
+		"bindings": [
+			{"ID": "a", "address": "10.0.0.1", "annotations": {"env": "prod"}},
+			{"ID": "b", "address": "10.0.0.2", "annotations": {}}
+		],
+		"binding": null
+	}`), &doc))
+
+	cases := []struct {
+		tmpl   string
+		output string
+	}{
+		{"{.size}", "2"},
+		{"size: {$.size}", "size: 2"},
+		{"{.bindings[0].address}", "10.0.0.1"},
+		{"{.bindings[-1].ID}", "b"},
+		{"{.bindings[*].address}", "10.0.0.1 10.0.0.2"},
+		{"{.bindings[0]['annotations'].env}", "prod"},
+		{"{.bindings[0].annotations.*}", "prod"},
+		{`{range .bindings[*]}{.ID}={.address}{"\n"}{end}`, "a=10.0.0.1\nb=10.0.0.2\n"},
+		{`{range .bindings[*]}{$.size}{end}`, "22"},
+		{"{.bindings[1].annotations}", "{}"},
+		{"{.binding.address}", ""},
+		{"plain", "plain"},
+	}
+
+	for _, c := range cases {
+		jp, err := parseJSONPath(c.tmpl)
+		if !assert.NoError(err, c.tmpl) {
+			continue
+		}
+		buf := &bytes.Buffer{}
+		assert.NoError(jp.Execute(buf, doc), c.tmpl)
+		assert.Equal(c.output, buf.String(), c.tmpl)
+	}
+
+	for _, tmpl := range []string{"{.size", "{range .bindings[*]}", "{end}", "{.bindings[x]}", "{size}", "{.a..b}"} {
+		_, err := parseJSONPath(tmpl)
+		assert.Error(err, tmpl)
+	}
+
+	for _, tmpl := range []string{"{.missing}", "{.bindings[5]}", "{.bindings.ID}", "{.size.value}"} {
+		jp, err := parseJSONPath(tmpl)
+		if assert.NoError(err, tmpl) {
+			assert.Error(jp.Execute(&bytes.Buffer{}, doc), tmpl)
+		}
+	}
+}
@@ -36,7 +36,21 @@ var (
 var PostalCmd = &cobra.Command{
 	Use:   "postal",
 	Short: "CLI tool to manage postal service",
-	Long:  ``,
+	Long: `CLI tool to manage postal service
+
+Exit codes:
+  0  success
+  1  error
+  2  the server could not be reached or did not answer in time
+  3  invalid input
+  5  the command was interrupted
+  7  not found
+  8  already exists
+  9  failed precondition, such as a quarantined address or a pool with bound addresses
+  10 pool exhausted
+  11 conflicting concurrent update, the command may be retried
+  12 permission denied
+  13 unauthenticated`,
 }
 
 // Execute adds all child commands to the root command sets flags appropriately.
@@ -44,14 +58,14 @@ var PostalCmd = &cobra.Command{
 func Execute() {
 	if err := PostalCmd.Execute(); err != nil {
 		fmt.Println(err)
-		os.Exit(-1)
+		os.Exit(exitCodeOf(err))
 	}
 }
 
 func init() {
 	PostalCmd.PersistentFlags().StringVar(&globalFlags.Endpoint, "endpoint", "127.0.0.1:7542", "gRPC endpoints")
 
-	PostalCmd.PersistentFlags().StringVarP(&globalFlags.OutputFormat, "write-out", "w", "simple", "set the output format (simple, json, table)")
+	PostalCmd.PersistentFlags().StringVarP(&globalFlags.OutputFormat, "write-out", "w", "simple", "set the output format (simple, json, yaml, table, go-template=..., jsonpath=...)")
 
 	PostalCmd.PersistentFlags().DurationVar(&globalFlags.DialTimeout, "dial-timeout", defaultDialTimeout, "dial timeout for client connections")
 	PostalCmd.PersistentFlags().DurationVar(&globalFlags.CommandTimeOut, "command-timeout", defaultCommandTimeOut, "timeout for short running command (excluding dial timeout)")
@@ -61,5 +75,6 @@ func init() {
 	PostalCmd.PersistentFlags().StringVar(&globalFlags.CertFile, "cert", "", "identify secure client using this TLS certificate file")
 	PostalCmd.PersistentFlags().StringVar(&globalFlags.KeyFile, "key", "", "identify secure client using this TLS key file")
 	PostalCmd.PersistentFlags().StringVar(&globalFlags.CAFile, "cacert", "", "verify certificates of TLS-enabled secure servers using this CA bundle")
+	PostalCmd.PersistentFlags().StringVar(&globalFlags.Token, "token", "", "bearer token to authenticate client connections with")
 
 }
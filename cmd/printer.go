@@ -13,7 +13,9 @@ import (
 
 type printer interface {
 	NetworkAdd(*api.NetworkAddResponse)
+	NetworkRemove(*api.NetworkRemoveResponse)
 	PoolAdd(*api.PoolAddResponse)
+	PoolRemove(*api.PoolRemoveResponse)
 
 	NetworkRange(*api.NetworkRangeResponse)
 	PoolRange(*api.PoolRangeResponse)
@@ -22,16 +24,45 @@ type printer interface {
 	AllocateAddress(*api.AllocateAddressResponse)
 	BulkAllocateAddress(*api.BulkAllocateAddressResponse)
 	BindAddress(*api.BindAddressResponse)
+	BindAddresses(*api.BindAddressesResponse)
 	ReleaseAddress(*api.ReleaseAddressResponse)
+	RenewBinding(*api.RenewBindingResponse)
 	PoolSetMax(*api.PoolSetMaxResponse)
+
+	Watch(*api.WatchResponse)
+
+	RoleRange(*api.RoleRangeResponse)
+	RoleSet(*api.RoleSetResponse)
+	RoleRemove(*api.RoleRemoveResponse)
+
+	AuditRange(*api.AuditRangeResponse)
+	AddressHistory(*api.AddressHistoryResponse)
 }
 
-func NewPrinter(printerType string) printer {
-	switch printerType {
+// NewPrinter returns the printer for an output format. The go-template and
+// jsonpath formats take their template after an equals sign, e.g.
+// jsonpath={.binding.address}.
+func NewPrinter(printerType string) (printer, error) {
+	format, tmpl := printerType, ""
+	if idx := strings.Index(printerType, "="); idx >= 0 {
+		format, tmpl = printerType[:idx], printerType[idx+1:]
+	}
+
+	switch format {
 	case "simple":
-		return &simplePrinter{}
+		return &simplePrinter{}, nil
+	case "json":
+		return newJSONPrinter(os.Stdout), nil
+	case "yaml":
+		return newYAMLPrinter(os.Stdout), nil
+	case "table":
+		return newTablePrinter(os.Stdout), nil
+	case "go-template":
+		return newGoTemplatePrinter(os.Stdout, tmpl)
+	case "jsonpath":
+		return newJSONPathPrinter(os.Stdout, tmpl)
 	}
-	return nil
+	return nil, fmt.Errorf("unsupported output format '%s'", printerType)
 }
 
 type simplePrinter struct{}
@@ -52,13 +83,17 @@ func (s *simplePrinter) PoolAdd(resp *api.PoolAddResponse) {
 	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
 	fmt.Fprintf(
 		w,
-		"network_id:%s\tpool_id:%s\tmax:%d\ttype:%s\tannotations:%s\n",
+		"network_id:%s\tpool_id:%s\tmax:%d\ttype:%s\tstrategy:%s\tannotations:%s\n",
 		resp.Pool.ID.NetworkID, resp.Pool.ID.ID,
-		resp.Pool.MaximumAddresses, resp.Pool.Type.String(),
+		resp.Pool.MaximumAddresses, resp.Pool.Type.String(), resp.Pool.Strategy.String(),
 		strings.Join(flattenAnnotations(resp.Pool.Annotations), ","))
 	w.Flush()
 }
 
+func (s *simplePrinter) NetworkRemove(resp *api.NetworkRemoveResponse) {}
+
+func (s *simplePrinter) PoolRemove(resp *api.PoolRemoveResponse) {}
+
 func (s *simplePrinter) NetworkRange(resp *api.NetworkRangeResponse) {
 	w := new(tabwriter.Writer)
 	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
@@ -72,11 +107,11 @@ func (s *simplePrinter) NetworkRange(resp *api.NetworkRangeResponse) {
 func (s *simplePrinter) PoolRange(resp *api.PoolRangeResponse) {
 	w := new(tabwriter.Writer)
 	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
-	fmt.Fprintln(w, "network_id\tpool_id\tmax\ttype\tannotations")
+	fmt.Fprintln(w, "network_id\tpool_id\tmax\ttype\tstrategy\tannotations")
 	for _, p := range resp.Pools {
-		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\n",
 			p.ID.NetworkID, p.ID.ID,
-			p.MaximumAddresses, p.Type.String(),
+			p.MaximumAddresses, p.Type.String(), p.Strategy.String(),
 			strings.Join(flattenAnnotations(p.Annotations), ","))
 	}
 	w.Flush()
@@ -122,13 +157,105 @@ func (s *simplePrinter) BindAddress(resp *api.BindAddressResponse) {
 	s.binding(w, resp.Binding)
 }
 
+func (s *simplePrinter) BindAddresses(resp *api.BindAddressesResponse) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "network_id\tpool_id\tbinding_id\taddress\tallocated\tstatus\tbound\treleased\tannotations")
+	for _, b := range resp.Bindings {
+		s.binding(w, b)
+	}
+	w.Flush()
+
+	if len(resp.Errors) > 0 {
+		fmt.Printf("%d of %d addresses bound, the following binds failed:\n", len(resp.Bindings), len(resp.Bindings)+len(resp.Errors))
+		for idx, berr := range resp.Errors {
+			fmt.Printf("---> %s: %s\n", idx, berr.Message)
+		}
+	}
+}
+
 func (s *simplePrinter) ReleaseAddress(resp *api.ReleaseAddressResponse) {}
 
+func (s *simplePrinter) RenewBinding(resp *api.RenewBindingResponse) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "network_id\tpool_id\tbinding_id\taddress\tallocated\tstatus\tbound\treleased\tannotations")
+	s.binding(w, resp.Binding)
+	w.Flush()
+}
+
+func (s *simplePrinter) Watch(resp *api.WatchResponse) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	for _, ev := range resp.Events {
+		switch {
+		case ev.Network != nil:
+			fmt.Fprintf(w, "%d\t%s\tnetwork\t%s\t%s\t%s\n",
+				resp.Revision, ev.Type.String(),
+				ev.Network.ID, ev.Network.Cidr,
+				strings.Join(flattenAnnotations(ev.Network.Annotations), ","))
+		case ev.Pool != nil:
+			fmt.Fprintf(w, "%d\t%s\tpool\t%s\t%s\t%d\t%s\t%s\n",
+				resp.Revision, ev.Type.String(),
+				ev.Pool.ID.NetworkID, ev.Pool.ID.ID,
+				ev.Pool.MaximumAddresses, ev.Pool.Type.String(),
+				strings.Join(flattenAnnotations(ev.Pool.Annotations), ","))
+		case ev.Binding != nil:
+			fmt.Fprintf(w, "%d\t%s\tbinding\t%s\t%s\t%s\t%s\t%s\n",
+				resp.Revision, ev.Type.String(),
+				ev.Binding.PoolID.NetworkID, ev.Binding.PoolID.ID, ev.Binding.ID, ev.Binding.Address,
+				strings.Join(flattenAnnotations(ev.Binding.Annotations), ","))
+		}
+	}
+	w.Flush()
+}
+
+func (s *simplePrinter) RoleRange(resp *api.RoleRangeResponse) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "role\tusers\tgroups\tmethods\tnetworks\tpools")
+	for _, role := range resp.Roles {
+		s.role(w, role)
+	}
+	w.Flush()
+}
+
+func (s *simplePrinter) RoleSet(resp *api.RoleSetResponse) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "role\tusers\tgroups\tmethods\tnetworks\tpools")
+	s.role(w, resp.Role)
+	w.Flush()
+}
+
+func (s *simplePrinter) RoleRemove(resp *api.RoleRemoveResponse) {}
+
+func (s *simplePrinter) AuditRange(resp *api.AuditRangeResponse) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "time\trevision\tidentity\tmethod\tcode\tnetwork_id\tpool_id\taddresses\terror")
+	for _, e := range resp.Entries {
+		fmt.Fprintln(w, strings.Join(auditRow(e, s), "\t"))
+	}
+	w.Flush()
+}
+
+func (s *simplePrinter) AddressHistory(resp *api.AddressHistoryResponse) {
+	s.BindingRange(&api.BindingRangeResponse{Bindings: resp.Bindings})
+}
+
+// role writes a line per rule of the role, naming the role only on the first.
+func (s *simplePrinter) role(w *tabwriter.Writer, role *api.Role) {
+	for _, row := range roleRows(role) {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+}
+
 func (s *simplePrinter) binding(w *tabwriter.Writer, b *api.Binding) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 		b.PoolID.NetworkID, b.PoolID.ID, b.ID, b.Address,
 		s.formatTime(time.Unix(0, b.AllocateTime)),
-		s.boundStatus(b.BindTime, b.ReleaseTime),
+		s.boundStatus(b),
 		s.formatTime(time.Unix(0, b.BindTime)),
 		s.formatTime(time.Unix(0, b.ReleaseTime)),
 		strings.Join(flattenAnnotations(b.Annotations), ","))
@@ -146,10 +273,12 @@ func (s *simplePrinter) formatTime(t time.Time) string {
 	return t.String()[0:19]
 }
 
-func (s *simplePrinter) boundStatus(bindTime int64, releaseTime int64) string {
-	if bindTime == 0 || bindTime < releaseTime {
-		return "AVAILABLE"
-	} else {
+func (s *simplePrinter) boundStatus(b *api.Binding) string {
+	if b.BindTime != 0 && b.BindTime >= b.ReleaseTime {
 		return "BOUND"
 	}
+	if remaining := time.Unix(0, b.QuarantineUntil).Sub(time.Now()); remaining > 0 {
+		return fmt.Sprintf("QUARANTINED (%s)", remaining/time.Second*time.Second)
+	}
+	return "AVAILABLE"
 }
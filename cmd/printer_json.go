@@ -0,0 +1,103 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/jive/postal/api"
+)
+
+// messagePrinter writes whole response messages, for use by scripts.
+type messagePrinter struct {
+	w       io.Writer
+	marshal func(proto.Message) ([]byte, error)
+	// separator precedes each document of a stream
+	separator string
+}
+
+func newJSONPrinter(w io.Writer) *messagePrinter {
+	return &messagePrinter{w: w, marshal: marshalJSON}
+}
+
+func newYAMLPrinter(w io.Writer) *messagePrinter {
+	return &messagePrinter{w: w, marshal: marshalYAML, separator: "---\n"}
+}
+
+// marshalJSON uses the proto3 JSON mapping with the field names of postal.proto,
+// so enums are written by name, 64 bit integers as strings and unset fields are
+// included.
+func marshalJSON(m proto.Message) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	err := (&jsonpb.Marshaler{EmitDefaults: true, OrigName: true, Indent: "  "}).Marshal(buf, m)
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func marshalYAML(m proto.Message) ([]byte, error) {
+	b, err := marshalJSON(m)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(b)
+}
+
+func (p *messagePrinter) print(m proto.Message) {
+	b, err := p.marshal(m)
+	if err != nil {
+		ExitWithError(ExitError, fmt.Errorf("failed to print %T: %v", m, err))
+	}
+	p.w.Write(b)
+}
+
+func (p *messagePrinter) NetworkAdd(resp *api.NetworkAddResponse)       { p.print(resp) }
+func (p *messagePrinter) NetworkRemove(resp *api.NetworkRemoveResponse) { p.print(resp) }
+func (p *messagePrinter) PoolAdd(resp *api.PoolAddResponse)             { p.print(resp) }
+func (p *messagePrinter) PoolRemove(resp *api.PoolRemoveResponse)       { p.print(resp) }
+
+func (p *messagePrinter) NetworkRange(resp *api.NetworkRangeResponse) { p.print(resp) }
+func (p *messagePrinter) PoolRange(resp *api.PoolRangeResponse)       { p.print(resp) }
+func (p *messagePrinter) BindingRange(resp *api.BindingRangeResponse) { p.print(resp) }
+
+func (p *messagePrinter) AllocateAddress(resp *api.AllocateAddressResponse)         { p.print(resp) }
+func (p *messagePrinter) BulkAllocateAddress(resp *api.BulkAllocateAddressResponse) { p.print(resp) }
+func (p *messagePrinter) BindAddress(resp *api.BindAddressResponse)                 { p.print(resp) }
+func (p *messagePrinter) BindAddresses(resp *api.BindAddressesResponse)             { p.print(resp) }
+func (p *messagePrinter) ReleaseAddress(resp *api.ReleaseAddressResponse)           { p.print(resp) }
+func (p *messagePrinter) RenewBinding(resp *api.RenewBindingResponse)               { p.print(resp) }
+func (p *messagePrinter) PoolSetMax(resp *api.PoolSetMaxResponse)                   { p.print(resp) }
+
+func (p *messagePrinter) RoleRange(resp *api.RoleRangeResponse)   { p.print(resp) }
+func (p *messagePrinter) RoleSet(resp *api.RoleSetResponse)       { p.print(resp) }
+func (p *messagePrinter) RoleRemove(resp *api.RoleRemoveResponse) { p.print(resp) }
+
+func (p *messagePrinter) AuditRange(resp *api.AuditRangeResponse)         { p.print(resp) }
+func (p *messagePrinter) AddressHistory(resp *api.AddressHistoryResponse) { p.print(resp) }
+
+// Watch writes a document per response.
+func (p *messagePrinter) Watch(resp *api.WatchResponse) {
+	io.WriteString(p.w, p.separator)
+	p.print(resp)
+}
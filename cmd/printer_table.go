@@ -0,0 +1,233 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/olekukonko/tablewriter"
+)
+
+var (
+	networkHeader = []string{"network_id", "cidr", "annotations"}
+	poolHeader    = []string{"network_id", "pool_id", "max", "type", "strategy", "annotations"}
+	bindingHeader = []string{"network_id", "pool_id", "binding_id", "address", "allocated", "status", "bound", "released", "annotations"}
+	eventHeader   = []string{"revision", "event", "kind", "network_id", "pool_id", "binding_id", "address", "annotations"}
+	roleHeader    = []string{"role", "users", "groups", "methods", "networks", "pools"}
+	auditHeader   = []string{"time", "revision", "identity", "method", "code", "network_id", "pool_id", "addresses", "error"}
+)
+
+// tablePrinter writes responses as bordered tables with aligned columns.
+type tablePrinter struct {
+	w io.Writer
+	// simple formats times and statuses
+	simple simplePrinter
+}
+
+func newTablePrinter(w io.Writer) *tablePrinter {
+	return &tablePrinter{w: w}
+}
+
+func (p *tablePrinter) render(header []string, rows [][]string) {
+	table := tablewriter.NewWriter(p.w)
+	table.SetHeader(header)
+	table.SetAutoFormatHeaders(false)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+func (p *tablePrinter) NetworkAdd(resp *api.NetworkAddResponse) {
+	p.render(networkHeader, [][]string{networkRow(resp.Network)})
+}
+
+func (p *tablePrinter) NetworkRemove(resp *api.NetworkRemoveResponse) {}
+
+func (p *tablePrinter) PoolAdd(resp *api.PoolAddResponse) {
+	p.render(poolHeader, [][]string{poolRow(resp.Pool)})
+}
+
+func (p *tablePrinter) PoolRemove(resp *api.PoolRemoveResponse) {}
+
+func (p *tablePrinter) NetworkRange(resp *api.NetworkRangeResponse) {
+	rows := [][]string{}
+	for _, n := range resp.Networks {
+		rows = append(rows, networkRow(n))
+	}
+	p.render(networkHeader, rows)
+}
+
+func (p *tablePrinter) PoolRange(resp *api.PoolRangeResponse) {
+	rows := [][]string{}
+	for _, pool := range resp.Pools {
+		rows = append(rows, poolRow(pool))
+	}
+	p.render(poolHeader, rows)
+}
+
+func (p *tablePrinter) BindingRange(resp *api.BindingRangeResponse) {
+	p.bindings(resp.Bindings)
+}
+
+func (p *tablePrinter) AllocateAddress(resp *api.AllocateAddressResponse) {
+	p.bindings([]*api.Binding{resp.Binding})
+}
+
+func (p *tablePrinter) BulkAllocateAddress(resp *api.BulkAllocateAddressResponse) {
+	p.bindings(resp.Bindings)
+	if len(resp.Errors) > 0 {
+		rows := [][]string{}
+		for ip, berr := range resp.Errors {
+			rows = append(rows, []string{ip, berr.Message})
+		}
+		p.render([]string{"address", "error"}, rows)
+	}
+}
+
+func (p *tablePrinter) BindAddress(resp *api.BindAddressResponse) {
+	p.bindings([]*api.Binding{resp.Binding})
+}
+
+func (p *tablePrinter) BindAddresses(resp *api.BindAddressesResponse) {
+	p.bindings(resp.Bindings)
+	if len(resp.Errors) > 0 {
+		rows := [][]string{}
+		for idx, berr := range resp.Errors {
+			rows = append(rows, []string{idx, berr.Message})
+		}
+		p.render([]string{"bind", "error"}, rows)
+	}
+}
+
+func (p *tablePrinter) ReleaseAddress(resp *api.ReleaseAddressResponse) {}
+
+func (p *tablePrinter) RenewBinding(resp *api.RenewBindingResponse) {
+	p.bindings([]*api.Binding{resp.Binding})
+}
+
+func (p *tablePrinter) PoolSetMax(resp *api.PoolSetMaxResponse) {}
+
+func (p *tablePrinter) Watch(resp *api.WatchResponse) {
+	rows := [][]string{}
+	for _, ev := range resp.Events {
+		row := []string{fmt.Sprint(resp.Revision), ev.Type.String()}
+		switch {
+		case ev.Network != nil:
+			row = append(row, "network", ev.Network.ID, "", "", "",
+				strings.Join(flattenAnnotations(ev.Network.Annotations), ","))
+		case ev.Pool != nil:
+			row = append(row, "pool", ev.Pool.ID.NetworkID, ev.Pool.ID.ID, "", "",
+				strings.Join(flattenAnnotations(ev.Pool.Annotations), ","))
+		case ev.Binding != nil:
+			row = append(row, "binding", ev.Binding.PoolID.NetworkID, ev.Binding.PoolID.ID,
+				ev.Binding.ID, ev.Binding.Address,
+				strings.Join(flattenAnnotations(ev.Binding.Annotations), ","))
+		default:
+			continue
+		}
+		rows = append(rows, row)
+	}
+	p.render(eventHeader, rows)
+}
+
+func (p *tablePrinter) RoleRange(resp *api.RoleRangeResponse) {
+	rows := [][]string{}
+	for _, role := range resp.Roles {
+		rows = append(rows, roleRows(role)...)
+	}
+	p.render(roleHeader, rows)
+}
+
+func (p *tablePrinter) RoleSet(resp *api.RoleSetResponse) {
+	p.render(roleHeader, roleRows(resp.Role))
+}
+
+func (p *tablePrinter) RoleRemove(resp *api.RoleRemoveResponse) {}
+
+func (p *tablePrinter) AuditRange(resp *api.AuditRangeResponse) {
+	rows := [][]string{}
+	for _, e := range resp.Entries {
+		rows = append(rows, auditRow(e, &p.simple))
+	}
+	p.render(auditHeader, rows)
+}
+
+func (p *tablePrinter) AddressHistory(resp *api.AddressHistoryResponse) {
+	p.bindings(resp.Bindings)
+}
+
+func (p *tablePrinter) bindings(bindings []*api.Binding) {
+	rows := [][]string{}
+	for _, b := range bindings {
+		rows = append(rows, []string{
+			b.PoolID.NetworkID, b.PoolID.ID, b.ID, b.Address,
+			p.simple.formatTime(time.Unix(0, b.AllocateTime)),
+			p.simple.boundStatus(b),
+			p.simple.formatTime(time.Unix(0, b.BindTime)),
+			p.simple.formatTime(time.Unix(0, b.ReleaseTime)),
+			strings.Join(flattenAnnotations(b.Annotations), ","),
+		})
+	}
+	p.render(bindingHeader, rows)
+}
+
+func networkRow(n *api.Network) []string {
+	return []string{n.ID, n.Cidr, strings.Join(flattenAnnotations(n.Annotations), ",")}
+}
+
+func poolRow(pool *api.Pool) []string {
+	return []string{
+		pool.ID.NetworkID, pool.ID.ID,
+		fmt.Sprint(pool.MaximumAddresses), pool.Type.String(), pool.Strategy.String(),
+		strings.Join(flattenAnnotations(pool.Annotations), ","),
+	}
+}
+
+// roleRows returns a row per rule of the role, the role columns are only set
+// on the first.
+func roleRows(role *api.Role) [][]string {
+	rows := [][]string{}
+	name := []string{role.Name, strings.Join(role.Users, ","), strings.Join(role.Groups, ",")}
+	for _, rule := range role.Rules {
+		rows = append(rows, append(name,
+			strings.Join(rule.Methods, ","),
+			strings.Join(rule.Networks, ","),
+			strings.Join(rule.Pools, ","),
+		))
+		name = []string{"", "", ""}
+	}
+	if len(rows) == 0 {
+		rows = append(rows, append(name, "", "", ""))
+	}
+	return rows
+}
+
+func auditRow(e *api.AuditEntry, s *simplePrinter) []string {
+	method := e.Method
+	if e.Replayed {
+		method += " (replayed)"
+	}
+	return []string{
+		s.formatTime(time.Unix(0, e.Time)), fmt.Sprint(e.Revision),
+		e.Identity, method, e.Code,
+		e.NetworkID, e.PoolID, strings.Join(e.Addresses, ","),
+		e.Error,
+	}
+}
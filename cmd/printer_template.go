@@ -0,0 +1,70 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"text/template"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// executor is implemented by text/template and jsonPath templates.
+type executor interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// newTemplatePrinter returns a printer which executes tmpl for each response,
+// with the response in the same form the json printer writes it.
+func newTemplatePrinter(w io.Writer, tmpl executor) *messagePrinter {
+	return &messagePrinter{w: w, marshal: func(m proto.Message) ([]byte, error) {
+		b, err := marshalJSON(m)
+		if err != nil {
+			return nil, err
+		}
+
+		var data interface{}
+		if err := json.Unmarshal(b, &data); err != nil {
+			return nil, err
+		}
+
+		buf := &bytes.Buffer{}
+		if err := tmpl.Execute(buf, data); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}}
+}
+
+func newGoTemplatePrinter(w io.Writer, text string) (*messagePrinter, error) {
+	tmpl, err := template.New("output").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid go-template")
+	}
+	return newTemplatePrinter(w, tmpl), nil
+}
+
+func newJSONPathPrinter(w io.Writer, text string) (*messagePrinter, error) {
+	tmpl, err := parseJSONPath(text)
+	if err != nil {
+		return nil, err
+	}
+	return newTemplatePrinter(w, tmpl), nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/jive/postal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPoolRange = &api.PoolRangeResponse{
+	Pools: []*api.Pool{{
+		ID:               &api.Pool_PoolID{NetworkID: "net1", ID: "pool1"},
+		Annotations:      map[string]string{"env": "prod"},
+		MaximumAddresses: 10,
+		Type:             api.Pool_FIXED,
+	}},
+	Size_: 1,
+}
+
+func TestNewPrinter(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, name := range []string{"simple", "json", "yaml", "table", "go-template={{.size}}", "jsonpath={.size}"} {
+		p, err := NewPrinter(name)
+		assert.NoError(err, name)
+		assert.NotNil(p, name)
+	}
+
+	for _, name := range []string{"xml", "go-template={{.size", "jsonpath={.size"} {
+		_, err := NewPrinter(name)
+		assert.Error(err, name)
+	}
+}
+
+func TestJSONPrinter(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	newJSONPrinter(buf).PoolRange(testPoolRange)
+
+	out := map[string]interface{}{}
+	assert.NoError(json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(float64(1), out["size"])
+	assert.Equal("", out["continuationToken"])
+
+	pools := out["pools"].([]interface{})
+	if assert.Len(pools, 1) {
+		pool := pools[0].(map[string]interface{})
+		assert.Equal("FIXED", pool["type"])
+		assert.Equal("10", pool["maximumAddresses"])
+		assert.Equal(map[string]interface{}{"env": "prod"}, pool["annotations"])
+		assert.Equal(map[string]interface{}{"networkID": "net1", "ID": "pool1"}, pool["ID"])
+	}
+}
+
+func TestYAMLPrinter(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	p := newYAMLPrinter(buf)
+	p.Watch(&api.WatchResponse{Revision: 4})
+	p.Watch(&api.WatchResponse{Revision: 5})
+
+	docs := strings.Split(buf.String(), "---\n")
+	if assert.Len(docs, 3) {
+		out := map[string]interface{}{}
+		assert.NoError(yaml.Unmarshal([]byte(docs[2]), &out))
+		assert.Equal("5", out["revision"])
+	}
+}
+
+func TestTablePrinter(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	newTablePrinter(buf).PoolRange(testPoolRange)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if assert.Len(lines, 5) {
+		assert.True(strings.HasPrefix(lines[0], "+-"))
+		assert.Contains(lines[1], "network_id")
+		assert.Contains(lines[3], "pool1")
+		assert.Contains(lines[3], "env=prod")
+		for _, line := range lines[1:] {
+			assert.Equal(len(lines[0]), len(line))
+		}
+	}
+}
+
+func TestRoleRows(t *testing.T) {
+	assert := assert.New(t)
+
+	role := &api.Role{
+		Name:   "proxy",
+		Groups: []string{"proxies", "lb"},
+		Rules: []*api.Role_Rule{
+			{Methods: []string{"BindAddress", "ReleaseAddress"}, Pools: []string{"pool1"}},
+			{Methods: []string{"BindingRange"}, Networks: []string{"net1"}},
+		},
+	}
+	assert.Equal([][]string{
+		{"proxy", "", "proxies,lb", "BindAddress,ReleaseAddress", "", "pool1"},
+		{"", "", "", "BindingRange", "net1", ""},
+	}, roleRows(role))
+
+	assert.Equal([][]string{{"empty", "", "", "", "", ""}}, roleRows(&api.Role{Name: "empty"}))
+}
+
+func TestBoundStatus(t *testing.T) {
+	assert := assert.New(t)
+	s := &simplePrinter{}
+	now := time.Now()
+
+	assert.Equal("AVAILABLE", s.boundStatus(&api.Binding{}))
+	assert.Equal("BOUND", s.boundStatus(&api.Binding{BindTime: now.UnixNano()}))
+	assert.Equal("AVAILABLE", s.boundStatus(&api.Binding{
+		BindTime:        now.Add(-time.Hour).UnixNano(),
+		ReleaseTime:     now.Add(-time.Minute).UnixNano(),
+		QuarantineUntil: now.Add(-time.Second).UnixNano(),
+	}))
+	assert.Contains(s.boundStatus(&api.Binding{
+		BindTime:        now.Add(-time.Hour).UnixNano(),
+		ReleaseTime:     now.Add(-time.Minute).UnixNano(),
+		QuarantineUntil: now.Add(time.Hour).UnixNano(),
+	}), "QUARANTINED (59m")
+}
+
+func TestTemplatePrinters(t *testing.T) {
+	assert := assert.New(t)
+
+	resp := &api.BindAddressResponse{Binding: &api.Binding{
+		PoolID:  &api.Pool_PoolID{NetworkID: "net1", ID: "pool1"},
+		ID:      "binding1",
+		Address: "10.0.0.1",
+	}}
+
+	buf := &bytes.Buffer{}
+	p, err := newJSONPathPrinter(buf, "{.binding.address}")
+	assert.NoError(err)
+	p.BindAddress(resp)
+	assert.Equal("10.0.0.1", buf.String())
+
+	buf.Reset()
+	p, err = newGoTemplatePrinter(buf, "{{.binding.poolID.ID}}/{{.binding.ID}}")
+	assert.NoError(err)
+	p.BindAddress(resp)
+	assert.Equal("pool1/binding1", buf.String())
+
+	buf.Reset()
+	p, err = newGoTemplatePrinter(buf, `{{range .pools}}{{.ID.ID}} {{.type}}{{"\n"}}{{end}}`)
+	assert.NoError(err)
+	p.PoolRange(testPoolRange)
+	assert.Equal("pool1 FIXED\n", buf.String())
+}
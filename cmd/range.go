@@ -28,6 +28,8 @@ import (
 )
 
 var human bool
+var rangePageSize int32
+var rangeSelector string
 
 // rangeCmd represents the range command
 var rangeCmd = &cobra.Command{
@@ -56,10 +58,24 @@ var networksCmd = &cobra.Command{
 			}
 		}
 
-		resp, err := mustClientFromCmd(cmd).NetworkRange(context.TODO(), req)
-		if err != nil {
-			return errors.Wrap(err, "failed to complete network range request")
+		client := mustClientFromCmd(cmd)
+		req.Size_ = rangePageSize
+		req.Selector = rangeSelector
+		resp := &api.NetworkRangeResponse{}
+		for {
+			page, err := client.NetworkRange(context.TODO(), req)
+			if err != nil {
+				return errors.Wrap(err, "failed to complete network range request")
+			}
+
+			resp.Networks = append(resp.Networks, page.Networks...)
+			if len(page.ContinuationToken) == 0 {
+				break
+			}
+			req.ContinuationToken = page.ContinuationToken
 		}
+
+		resp.Size_ = int32(len(resp.Networks))
 		display.NetworkRange(resp)
 		return nil
 	},
@@ -84,11 +100,25 @@ var poolsCmd = &cobra.Command{
 			}
 		}
 
-		resp, err := mustClientFromCmd(cmd).PoolRange(context.TODO(), req)
-		if err != nil {
-			return errors.Wrap(err, "failed to complete pool range request")
+		client := mustClientFromCmd(cmd)
+		req.Size_ = rangePageSize
+		req.Selector = rangeSelector
+		resp := &api.PoolRangeResponse{}
+		for {
+			page, err := client.PoolRange(context.TODO(), req)
+			if err != nil {
+				return errors.Wrap(err, "failed to complete pool range request")
+			}
+
+			resp.Pools = append(resp.Pools, page.Pools...)
+			if len(page.ContinuationToken) == 0 {
+				break
+			}
+			req.ContinuationToken = page.ContinuationToken
 		}
 
+		resp.Size_ = int32(len(resp.Pools))
+
 		display.PoolRange(resp)
 		return nil
 	},
@@ -106,22 +136,64 @@ var bindingsCmd = &cobra.Command{
 		req.NetworkID = args[0]
 		req.Filters = parseAnnotations(args[1:len(args)])
 
-		resp, err := mustClientFromCmd(cmd).BindingRange(context.TODO(), req)
-		if err != nil {
-			return errors.Wrap(err, "failed to complete binding range request")
+		client := mustClientFromCmd(cmd)
+		req.Size_ = rangePageSize
+		req.Selector = rangeSelector
+		resp := &api.BindingRangeResponse{}
+		for {
+			page, err := client.BindingRange(context.TODO(), req)
+			if err != nil {
+				return errors.Wrap(err, "failed to complete binding range request")
+			}
+
+			resp.Bindings = append(resp.Bindings, page.Bindings...)
+			if len(page.ContinuationToken) == 0 {
+				break
+			}
+			req.ContinuationToken = page.ContinuationToken
 		}
 
+		resp.Size_ = int32(len(resp.Bindings))
+
 		display.BindingRange(resp)
 		return nil
 	},
 }
 
+var rolesCmd = &cobra.Command{
+	Use:   "roles",
+	Short: "view roles stored on the server",
+	Long:  `postal range roles [name]`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return errors.New("[name] must be the only argument")
+		}
+
+		req := &api.RoleRangeRequest{}
+		if len(args) == 1 {
+			req.Name = args[0]
+		}
+
+		resp, err := mustClientFromCmd(cmd).RoleRange(context.TODO(), req)
+		if err != nil {
+			return errors.Wrap(err, "failed to complete role range request")
+		}
+
+		display.RoleRange(resp)
+		return nil
+	},
+}
+
 func init() {
 	PostalCmd.AddCommand(rangeCmd)
 
 	rangeCmd.AddCommand(networksCmd)
 	rangeCmd.AddCommand(poolsCmd)
 	rangeCmd.AddCommand(bindingsCmd)
+	rangeCmd.AddCommand(rolesCmd)
+
+	rangeCmd.PersistentFlags().Int32Var(&rangePageSize, "page-size", 500, "number of resources fetched per request")
+	rangeCmd.PersistentFlags().StringVarP(&rangeSelector, "selector", "l", "", "selector expression to filter on, e.g. 'env in (prod,qa),_status=bound'")
 
 	bindingsCmd.Flags().BoolVarP(&human, "human", "d", false, "humanize output")
 
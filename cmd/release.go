@@ -37,9 +37,15 @@ var releaseCmd = &cobra.Command{
 			return errors.Wrap(err, "failed to parse --hard flag")
 		}
 
+		idempotencyKey, err := cmd.Flags().GetString("idempotency-key")
+		if err != nil {
+			return err
+		}
+
 		req := &api.ReleaseAddressRequest{
-			PoolID: &api.Pool_PoolID{},
-			Hard:   hard,
+			PoolID:         &api.Pool_PoolID{},
+			Hard:           hard,
+			IdempotencyKey: idempotencyKey,
 		}
 
 		switch len(args) {
@@ -69,4 +75,5 @@ func init() {
 	PostalCmd.AddCommand(releaseCmd)
 
 	releaseCmd.Flags().Bool("hard", false, "hard release")
+	releaseCmd.Flags().String("idempotency-key", "", "retries with the same key get the original response instead of releasing again")
 }
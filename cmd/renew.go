@@ -0,0 +1,60 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// renewCmd represents the renew command
+var renewCmd = &cobra.Command{
+	Use:   "renew",
+	Short: "renew the lease of a binding",
+	Long:  `postal renew <networkID> <poolID> <bindingID>`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 3 {
+			return fmt.Errorf("invalid arguments")
+		}
+
+		req := &api.RenewBindingRequest{
+			PoolID: &api.Pool_PoolID{
+				NetworkID: args[0],
+				ID:        args[1],
+			},
+			BindingID: args[2],
+		}
+
+		resp, err := mustClientFromCmd(cmd).RenewBinding(context.TODO(), req)
+		if err != nil {
+			return errors.Wrap(err, "renew rpc failed")
+		}
+
+		display.RenewBinding(resp)
+
+		return nil
+	},
+}
+
+func init() {
+	PostalCmd.AddCommand(renewCmd)
+}
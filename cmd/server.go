@@ -19,18 +19,49 @@ package cmd
 import (
 	"crypto/tls"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/pkg/capnslog"
+	"github.com/jive/postal/api"
 	"github.com/jive/postal/server"
+	"github.com/jive/postal/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 var etcdEndpoints []string
 var etcdDialTimeout time.Duration
 var serverDebug bool
+var serverStorage string
+var serverDataDir string
+var serverHealthInterval time.Duration
+var serverShutdownTimeout time.Duration
+var serverMetricsAddr string
+var serverGatewayAddr string
+var serverClientCertAuth bool
+var serverAuthTokens []string
+var serverAuthTokenFile string
+var serverRBACPolicyFile string
+var serverRBACStoredRoles bool
+var serverAuditRetention time.Duration
+var serverIdempotencyWindow time.Duration
+
+// auditPruneInterval is the time between deletions of expired audit entries.
+const auditPruneInterval = time.Hour
 
 // serverCmd represents the bind command
 var serverCmd = &cobra.Command{
@@ -42,44 +73,283 @@ var serverCmd = &cobra.Command{
 			capnslog.SetGlobalLogLevel(capnslog.DEBUG)
 		}
 		plog.Info("starting postal server")
-		cli, err := clientv3.New(clientv3.Config{
-			Endpoints:   etcdEndpoints,
-			DialTimeout: etcdDialTimeout,
-		})
+		store := mustBuildStore()
+		defer store.Close()
 
-		plog.Infof("configuring server with etcd endpoints [%s]", cli.Endpoints())
-		if err != nil {
-			plog.Fatalf("failed to open etcd client conn: %s", err)
-		}
-		defer cli.Close()
-
-		var lis net.Listener
 		plog.Infof("listening for client connections on [%s]", globalFlags.Endpoint)
-		lis, err = net.Listen("tcp", globalFlags.Endpoint)
+		lis, err := net.Listen("tcp", globalFlags.Endpoint)
 		if err != nil {
 			plog.Fatalf("failed to start listener: %s", err)
 		}
 		defer lis.Close()
 
+		unary := []grpc.UnaryServerInterceptor{server.UnaryMetricsInterceptor, server.UnaryErrorInterceptor}
+		stream := []grpc.StreamServerInterceptor{server.StreamMetricsInterceptor, server.StreamErrorInterceptor}
+		auth := mustBuildAuthenticator()
+		if auth != nil {
+			unary = append(unary, auth.UnaryInterceptor)
+			stream = append(stream, auth.StreamInterceptor)
+		}
+		unary = append(unary, server.NewAuditor(store).UnaryInterceptor)
+		authz := mustBuildAuthorizer(store)
+		if authz != nil {
+			if auth == nil {
+				plog.Fatal("--rbac-policy-file and --rbac-stored-roles require authentication")
+			}
+			unary = append(unary, authz.UnaryInterceptor)
+			stream = append(stream, authz.StreamInterceptor)
+		}
+		if serverIdempotencyWindow > 0 {
+			if serverIdempotencyWindow < time.Second {
+				plog.Fatal("--idempotency-window must be at least a second")
+			}
+			unary = append(unary, server.NewIdempotency(store, serverIdempotencyWindow).UnaryInterceptor)
+		}
+		opts := []grpc.ServerOption{
+			grpc.UnaryInterceptor(server.ChainUnaryInterceptors(unary...)),
+			grpc.StreamInterceptor(server.ChainStreamInterceptors(stream...)),
+		}
+
 		scfg := secureCfgFromCmd(cmd)
+		var tlsConfig *tls.Config
 		if scfg.insecureTransport {
 			plog.Info("listener configured for insecure transport")
+			if serverClientCertAuth {
+				plog.Fatal("--client-cert-auth requires TLS transport")
+			}
 		} else {
 			plog.Info("configuring listener for TLS transport")
-			lis = tls.NewListener(lis, mustBuildTLSConfig(scfg))
+			tlsConfig = mustBuildServerTLSConfig(scfg)
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 		}
 
-		grpcServer := grpc.NewServer()
-		srv := server.NewServer(cli)
+		grpcServer := grpc.NewServer(opts...)
+		srv := server.NewServer(store)
 		srv.Register(grpcServer)
-		grpcServer.Serve(lis)
+
+		if len(serverMetricsAddr) > 0 {
+			prometheus.MustRegister(srv.UsageCollector())
+			go serveMetrics(serverMetricsAddr)
+		}
+
+		hs := health.NewServer()
+		healthpb.RegisterHealthServer(grpcServer, hs)
+		reflection.Register(grpcServer)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		if len(serverGatewayAddr) > 0 {
+			// the gateway forwards the bearer tokens of http requests. They are required
+			// when rpcs are authenticated, as the gateway connects with the server certificate
+			gwcfg := *scfg
+			gwcfg.token = ""
+			var gwopts []server.GatewayOption
+			if auth != nil {
+				gwopts = append(gwopts, server.RequireBearerToken())
+			}
+			go serveGateway(ctx, serverGatewayAddr, mustDialOptions(&gwcfg), tlsConfig, gwopts...)
+		}
+
+		go srv.WatchHealth(ctx, hs, serverHealthInterval)
+		if serverAuditRetention > 0 {
+			go srv.PruneAudit(ctx, serverAuditRetention, auditPruneInterval)
+		}
+		go srv.ExpireBindings(ctx)
+		if authz != nil {
+			go authz.WatchRoles(ctx)
+		}
+
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGTERM, syscall.SIGINT)
+
+		errc := make(chan error, 1)
+		go func() {
+			errc <- grpcServer.Serve(lis)
+		}()
+
+		select {
+		case err := <-errc:
+			plog.Errorf("grpc server stopped: %s", err)
+		case sig := <-sigc:
+			plog.Infof("received %s, draining in-flight rpcs", sig)
+			cancel()
+			gracefulStop(grpcServer, serverShutdownTimeout)
+		}
 	},
 }
 
+// gracefulStop stops the server once all rpcs finished, rpcs still running after
+// timeout are cancelled.
+func gracefulStop(s *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		plog.Info("all rpcs finished, server stopped")
+	case <-time.After(timeout):
+		plog.Warningf("rpcs still running after %s, stopping server", timeout)
+		s.Stop()
+	}
+}
+
+// serveMetrics serves the prometheus metrics of the server on addr under /metrics.
+func serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	plog.Infof("serving metrics on [%s]", addr)
+	err := http.ListenAndServe(addr, mux)
+	if err != nil {
+		plog.Errorf("metrics listener stopped: %s", err)
+	}
+}
+
+// serveGateway serves the HTTP+JSON gateway to the grpc server on addr, over TLS
+// with tlsConfig unless it is nil.
+func serveGateway(ctx context.Context, addr string, opts []grpc.DialOption, tlsConfig *tls.Config, gwopts ...server.GatewayOption) {
+	gw, err := server.NewGateway(ctx, globalFlags.Endpoint, opts, gwopts...)
+	if err != nil {
+		plog.Errorf("failed to start gateway: %s", err)
+		return
+	}
+
+	srv := &http.Server{Addr: addr, Handler: gw}
+	if tlsConfig == nil {
+		plog.Infof("serving http gateway on [%s]", addr)
+		err = srv.ListenAndServe()
+	} else {
+		plog.Infof("serving https gateway on [%s]", addr)
+		srv.TLSConfig = tlsConfig
+		err = srv.ListenAndServeTLS("", "")
+	}
+	if err != nil {
+		plog.Errorf("gateway listener stopped: %s", err)
+	}
+}
+
+// mustBuildServerTLSConfig returns the TLS config of the listener, which requires
+// client certificates signed by --cacert if --client-cert-auth is set.
+func mustBuildServerTLSConfig(scfg *secureCfg) *tls.Config {
+	tlsConfig := mustBuildTLSConfig(scfg)
+	if serverClientCertAuth {
+		if tlsConfig.RootCAs == nil {
+			plog.Fatal("--client-cert-auth requires --cacert")
+		}
+		plog.Info("requiring client certificates")
+		tlsConfig.ClientCAs = tlsConfig.RootCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig
+}
+
+// mustBuildAuthenticator returns the authenticator of the configured client
+// certificate and token authentication, or nil if rpcs are unauthenticated.
+func mustBuildAuthenticator() *server.Authenticator {
+	if !serverClientCertAuth && len(serverAuthTokens) == 0 && serverAuthTokenFile == "" {
+		plog.Warning("authentication disabled, all callers are anonymous")
+		return nil
+	}
+
+	auth := server.NewAuthenticator()
+	for _, t := range serverAuthTokens {
+		idx := strings.Index(t, ":")
+		if idx < 1 || idx == len(t)-1 {
+			plog.Fatalf("--auth-token %q is not of the form name:token", t)
+		}
+		auth.AddToken(t[idx+1:], &server.Identity{Name: t[:idx]})
+	}
+	if serverAuthTokenFile != "" {
+		err := auth.LoadTokenFile(serverAuthTokenFile)
+		if err != nil {
+			plog.Fatalf("failed to load tokens: %s", err)
+		}
+	}
+	return auth
+}
+
+// mustBuildAuthorizer returns the authorizer of the roles of --rbac-policy-file
+// and, with --rbac-stored-roles, those in store, or nil if rpcs are not authorized.
+func mustBuildAuthorizer(store storage.Store) *server.Authorizer {
+	if serverRBACPolicyFile == "" && !serverRBACStoredRoles {
+		return nil
+	}
+
+	roles := []*api.Role{}
+	if serverRBACPolicyFile != "" {
+		var err error
+		roles, err = server.LoadPolicyFile(serverRBACPolicyFile)
+		if err != nil {
+			plog.Fatalf("failed to load rbac policy: %s", err)
+		}
+		plog.Infof("loaded %d roles from [%s]", len(roles), serverRBACPolicyFile)
+	}
+
+	authz := server.NewAuthorizer(roles)
+	if serverRBACStoredRoles {
+		plog.Info("authorizing rpcs with stored roles")
+		if len(roles) == 0 {
+			plog.Warning("no roles in --rbac-policy-file, roles can only be stored once a caller is granted RoleSet")
+		}
+		authz.WithStoredRoles(store)
+	}
+	return authz
+}
+
 func init() {
 	PostalCmd.AddCommand(serverCmd)
 
 	serverCmd.Flags().StringSliceVar(&etcdEndpoints, "etcd", []string{"127.0.0.1:2379"}, "etcd servers to use")
 	serverCmd.Flags().DurationVar(&etcdDialTimeout, "etcd-timeout", 5*time.Second, "etcd dial timeout")
+	serverCmd.Flags().StringVar(&serverStorage, "storage", "etcd", "storage backend to use, one of etcd|bolt|memory")
+	serverCmd.Flags().StringVar(&serverDataDir, "data-dir", "/var/lib/postal", "directory of the bolt storage backend")
 	serverCmd.Flags().BoolVar(&serverDebug, "debug", false, "enable debug logging")
+	serverCmd.Flags().DurationVar(&serverHealthInterval, "health-interval", 5*time.Second, "interval between storage health checks")
+	serverCmd.Flags().DurationVar(&serverShutdownTimeout, "shutdown-timeout", 30*time.Second, "time to let in-flight rpcs finish on SIGTERM")
+	serverCmd.Flags().StringVar(&serverMetricsAddr, "metrics-addr", "", "address to serve prometheus metrics on, disabled if empty")
+	serverCmd.Flags().BoolVar(&serverClientCertAuth, "client-cert-auth", false, "require client certificates signed by --cacert, identifying callers by their subject")
+	serverCmd.Flags().StringSliceVar(&serverAuthTokens, "auth-token", []string{}, "accept a bearer token, given as name:token")
+	serverCmd.Flags().StringVar(&serverAuthTokenFile, "auth-token-file", "", "accept the bearer tokens of a csv file with lines of token,name[,group...]")
+	serverCmd.Flags().StringVar(&serverRBACPolicyFile, "rbac-policy-file", "", "authorize rpcs by the roles of a yaml or json file")
+	serverCmd.Flags().BoolVar(&serverRBACStoredRoles, "rbac-stored-roles", false, "authorize rpcs by the roles stored with 'postal create role'")
+	serverCmd.Flags().DurationVar(&serverAuditRetention, "audit-retention", 90*24*time.Hour, "time audit entries are kept for, forever if 0")
+	serverCmd.Flags().DurationVar(&serverIdempotencyWindow, "idempotency-window", 24*time.Hour, "time responses are replayed to retries with the same idempotency key, disabled if 0")
+	serverCmd.Flags().StringVar(&serverGatewayAddr, "gateway-addr", "", "address to serve the http+json gateway and its OpenAPI spec on, over TLS unless --insecure-transport, disabled if empty")
+}
+
+// mustBuildStore opens the storage backend selected by --storage.
+func mustBuildStore() storage.Store {
+	switch serverStorage {
+	case "etcd":
+		cli, err := clientv3.New(clientv3.Config{
+			Endpoints:   etcdEndpoints,
+			DialTimeout: etcdDialTimeout,
+		})
+		if err != nil {
+			plog.Fatalf("failed to open etcd client conn: %s", err)
+		}
+		plog.Infof("configuring server with etcd endpoints [%s]", cli.Endpoints())
+		return storage.NewEtcdStore(cli)
+	case "bolt":
+		err := os.MkdirAll(serverDataDir, 0700)
+		if err != nil {
+			plog.Fatalf("failed to create data dir: %s", err)
+		}
+		plog.Infof("configuring server with bolt storage in [%s]", serverDataDir)
+		store, err := storage.NewBoltStore(filepath.Join(serverDataDir, "postal.db"))
+		if err != nil {
+			plog.Fatalf("failed to open bolt storage: %s", err)
+		}
+		return store
+	case "memory":
+		plog.Warning("configuring server with in-memory storage, all state is lost when the server stops")
+		return storage.NewMemoryStore()
+	default:
+		plog.Fatalf("unknown storage backend %q, expected etcd, bolt or memory", serverStorage)
+	}
+	return nil
 }
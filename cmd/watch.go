@@ -0,0 +1,74 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/net/context"
+
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var watchRevision int64
+var watchSelector string
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "stream changes of networks, pools and bindings",
+	Long:  `postal watch [key=value ...] [-l <selector>] [--rev <revision>]`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &api.WatchRequest{
+			Filters:  parseAnnotations(args),
+			Revision: watchRevision,
+			Selector: watchSelector,
+		}
+
+		stream, err := mustClientFromCmd(cmd).Watch(context.Background(), req)
+		if err != nil {
+			return errors.Wrap(err, "watch rpc failed")
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return errors.Wrap(err, "watch rpc failed")
+			}
+
+			if len(resp.Events) == 0 {
+				fmt.Fprintf(os.Stderr, "watching from revision %d\n", resp.Revision+1)
+				continue
+			}
+			display.Watch(resp)
+		}
+	},
+}
+
+func init() {
+	PostalCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().Int64Var(&watchRevision, "rev", 0, "revision to start watching at, defaults to the current revision")
+	watchCmd.Flags().StringVarP(&watchSelector, "selector", "l", "", "selector expression to filter on, e.g. '_type=dynamic,!owner'")
+}
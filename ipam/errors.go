@@ -0,0 +1,50 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import "fmt"
+
+// ErrorKind tells apart the reasons an ipam operation failed.
+type ErrorKind int
+
+const (
+	// ErrNotFound means the ipam does not exist.
+	ErrNotFound ErrorKind = iota + 1
+	// ErrAlreadyExists means the address is already claimed.
+	ErrAlreadyExists
+	// ErrExhausted means there are no addresses left to allocate.
+	ErrExhausted
+	// ErrConflict means concurrent updates kept the operation from committing.
+	ErrConflict
+	// ErrInvalidArgument means the address can never be handed out.
+	ErrInvalidArgument
+)
+
+// Error is an ipam failure of a known kind. Wrapped errors are told apart by
+// their cause.
+type Error struct {
+	Kind ErrorKind
+	msg  string
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func errorf(kind ErrorKind, format string, args ...interface{}) error {
+	return &Error{Kind: kind, msg: fmt.Sprintf(format, args...)}
+}
@@ -17,16 +17,19 @@ limitations under the License.
 package ipam
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math"
 	"net"
 	"path"
+	"sort"
 	"sync"
+	"time"
 
 	"golang.org/x/net/context"
 
-	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/jive/postal/storage"
 	"github.com/pkg/errors"
 	"github.com/twinj/uuid"
 )
@@ -58,12 +61,26 @@ type IPAM interface {
 	// Claim forces a claim on a specific address.
 	// If the requested address has already been allocated, this will return an error
 	Claim(net.IP) error
+	// ClaimOp prepares a claim on a specific address without committing it.
+	// The returned comparisons and operations must be applied in a single store transaction,
+	// which allows callers to fold the claim into their own writes.
+	ClaimOp(net.IP) ([]storage.Cmp, []storage.Op, error)
+	// ReleaseOp prepares the release of a specific address without committing it.
+	ReleaseOp(net.IP) ([]storage.Cmp, []storage.Op, error)
+	// AllocateOp prepares the allocation of the next free address without committing it.
+	AllocateOp() (net.IP, []storage.Cmp, []storage.Op, error)
+	// AllocateNOp prepares the allocation of the next n free addresses without committing it.
+	AllocateNOp(n int) ([]net.IP, []storage.Cmp, []storage.Op, error)
 	// IsAvailable checks to see if a specifc IP as been allocated.
 	IsAvailable(net.IP) bool
-	// Size returns the cardinality of the set of addresses the IPAM object tracks.
+	// Size returns the cardinality of the set of addresses the IPAM object tracks,
+	// capped at math.MaxUint64.
 	Size() uint64
 	// Available returns the cardinality of the non-allocated set of addresses.
 	Available() uint64
+	// Allocated returns the number of addresses allocated so far, including the
+	// reserved network and broadcast addresses.
+	Allocated() (uint64, error)
 	// GetID is the unique identifier for the ipam module
 	GetID() string
 }
@@ -75,46 +92,63 @@ type ipamEtcdBlock struct {
 	version int64
 }
 
-// Cmp returns a slice or etcd comparison operations for use in key transactions.
-func (block *ipamEtcdBlock) Cmp() []etcd.Cmp {
-	return []etcd.Cmp{
-		etcd.Compare(etcd.Version(block.key), "=", block.version),
+// Cmp returns a slice of store comparison operations for use in key transactions.
+func (block *ipamEtcdBlock) Cmp() []storage.Cmp {
+	return []storage.Cmp{
+		storage.Compare(storage.Version(block.key), "=", block.version),
 	}
 }
 
-// PutOp returns a slice or etcd put operations for use in key transactions.
-func (block *ipamEtcdBlock) PutOp() []etcd.Op {
+// PutOp returns a slice of store put operations for use in key transactions.
+func (block *ipamEtcdBlock) PutOp() []storage.Op {
 	blockJSON, _ := block.block.MarshalJSON()
-	return []etcd.Op{
-		etcd.OpPut(block.key, string(blockJSON)),
+	return []storage.Op{
+		storage.OpPut(block.key, string(blockJSON)),
 	}
 }
 
+// blocksByAddr sorts ipam blocks by their subnet address
+type blocksByAddr []*ipamEtcdBlock
+
+func (b blocksByAddr) Len() int      { return len(b) }
+func (b blocksByAddr) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b blocksByAddr) Less(i, j int) bool {
+	return bytes.Compare(b[i].block.Subnet.IP.To16(), b[j].block.Subnet.IP.To16()) < 0
+}
+
 type etcdIPAM struct {
 	ID          string
 	net         *net.IPNet
-	etcd        *etcd.Client
+	store       storage.Store
 	nextKey     string
 	nextKeyLock sync.Locker
 }
 
 // FetchIPAM fetches the IPAM object for the given ID.
-func FetchIPAM(ID string, client *etcd.Client) (IPAM, error) {
-	resp, err := client.KV.Get(context.TODO(), path.Join(IpamEtcdKeyPrefix, ID, "cidr"))
+func FetchIPAM(ID string, store storage.Store) (IPAM, error) {
+	resp, err := store.Get(context.TODO(), path.Join(IpamEtcdKeyPrefix, ID, "cidr"))
 	if err != nil {
 		return nil, err
 	}
 
+	if len(resp.Kvs) == 0 {
+		return nil, errorf(ErrNotFound, "ipam: could not find ipam %s", ID)
+	}
+
 	_, ipnet, _ := net.ParseCIDR(string(resp.Kvs[0].Value))
-	resp, err = client.KV.Get(context.TODO(), path.Join(IpamEtcdKeyPrefix, ID, "nextKey"))
+	resp, err = store.Get(context.TODO(), path.Join(IpamEtcdKeyPrefix, ID, "nextKey"))
 	if err != nil {
 		return nil, err
 	}
 
+	if len(resp.Kvs) == 0 {
+		return nil, errorf(ErrNotFound, "ipam: could not find ipam %s", ID)
+	}
+
 	i := &etcdIPAM{
 		ID:          ID,
 		net:         ipnet,
-		etcd:        client,
+		store:       store,
 		nextKey:     string(resp.Kvs[0].Value),
 		nextKeyLock: &sync.Mutex{},
 	}
@@ -122,31 +156,31 @@ func FetchIPAM(ID string, client *etcd.Client) (IPAM, error) {
 	return i, nil
 }
 
-// NewIPAM takes a cidr block and etcd client and returns an implementaton of the IPAM interface.
+// NewIPAM takes a cidr block and store and returns an implementaton of the IPAM interface.
 // If the cidr block is larger than the MinBlockSize for the givcen address family,
 // the IPAM module will divide the block into multiple sublocks the size of MinBlockSize.
-func NewIPAM(cidr string, client *etcd.Client) (IPAM, error) {
+func NewIPAM(cidr string, store storage.Store) (IPAM, error) {
 	_, ipnet, err := net.ParseCIDR(cidr)
 	if err != nil {
-		return nil, err
+		return nil, errorf(ErrInvalidArgument, "ipam: %v", err)
 	}
 
 	i := &etcdIPAM{
 		ID:          uuid.NewV4().String(),
 		net:         ipnet,
-		etcd:        client,
+		store:       store,
 		nextKey:     ipnet.IP.String(),
 		nextKeyLock: &sync.Mutex{},
 	}
 
-	resp, err := client.KV.Txn(context.TODO()).If(
-		etcd.Compare(etcd.Version(path.Join(IpamEtcdKeyPrefix, i.ID, "nextKey")), "=", 0),
+	resp, err := store.Txn(context.TODO()).If(
+		storage.Compare(storage.Version(path.Join(IpamEtcdKeyPrefix, i.ID, "nextKey")), "=", 0),
 	).Then(
-		etcd.OpPut(
+		storage.OpPut(
 			path.Join(IpamEtcdKeyPrefix, i.ID, "nextKey"),
 			i.nextKey,
 		),
-		etcd.OpPut(path.Join(IpamEtcdKeyPrefix, i.ID, "cidr"), cidr),
+		storage.OpPut(path.Join(IpamEtcdKeyPrefix, i.ID, "cidr"), cidr),
 	).Commit()
 
 	if err != nil {
@@ -157,7 +191,7 @@ func NewIPAM(cidr string, client *etcd.Client) (IPAM, error) {
 		return i, nil
 	}
 
-	return nil, fmt.Errorf("ipam: failed to persist IPAM to datastore")
+	return nil, errorf(ErrConflict, "ipam: failed to persist IPAM to datastore")
 }
 
 func (ipam *etcdIPAM) String() string {
@@ -173,7 +207,7 @@ ALLOCATE:
 	// allocatedBlocks holds the set of addresses to be returned to the caller.
 	allocatedAddresses := []net.IP{}
 
-	// toCommit holds the set of ipamBlocks that need to be committed to the etcd.
+	// toCommit holds the set of ipamBlocks that need to be committed to the store.
 	toCommit := []*ipamEtcdBlock{}
 
 	for _, block := range blocks {
@@ -205,6 +239,7 @@ ALLOCATE:
 		if err != nil {
 			if retryCount < PostalIPAMRetryMax {
 				retryCount++
+				allocateRetries.Inc()
 				goto ALLOCATE
 			} else {
 				return nil, errors.Wrap(err, "nextBlock failed")
@@ -219,25 +254,112 @@ ALLOCATE:
 		toCommit = append(toCommit, block)
 	}
 
-	cmps := []etcd.Cmp{}
-	ops := []etcd.Op{}
+	cmps := []storage.Cmp{}
+	ops := []storage.Op{}
 	for _, block := range toCommit {
 		cmps = append(cmps, block.Cmp()...)
 		ops = append(ops, block.PutOp()...)
 	}
 
-	resp, err := ipam.etcd.KV.Txn(context.Background()).If(cmps...).Then(ops...).Commit()
+	resp, err := ipam.store.Txn(context.Background()).If(cmps...).Then(ops...).Commit()
 	if err != nil {
 		return nil, errors.Wrap(err, "etcd allocate transaction failed")
 	}
 	if !resp.Succeeded {
-		//TODO:backoff/rety count
-		goto ALLOCATE
+		allocateCASFailures.Inc()
+		if retryCount < PostalIPAMRetryMax {
+			retryCount++
+			allocateRetries.Inc()
+			time.Sleep(RetryBackoff(retryCount))
+			goto ALLOCATE
+		}
+		return nil, errorf(ErrConflict, "ipam/allocate: too many conflicting updates")
 	}
 
 	return allocatedAddresses, nil
 }
 
+func (ipam *etcdIPAM) AllocateOp() (net.IP, []storage.Cmp, []storage.Op, error) {
+	ips, cmps, ops, err := ipam.AllocateNOp(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return ips[0], cmps, ops, nil
+}
+
+func (ipam *etcdIPAM) AllocateNOp(n int) ([]net.IP, []storage.Cmp, []storage.Op, error) {
+	blocks, err := ipam.fetchIpamBlocks()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// walk the provisioned blocks in address order so addresses are handed out lowest first
+	sorted := make(blocksByAddr, 0, len(blocks))
+	for _, block := range blocks {
+		sorted = append(sorted, block)
+	}
+	sort.Sort(sorted)
+
+	ips := []net.IP{}
+	touched := []*ipamEtcdBlock{}
+	request := func(block *ipamEtcdBlock) {
+		requested := len(ips)
+		for len(ips) < n {
+			ip := ipam.requestInRange(block.block)
+			if ip == nil {
+				break
+			}
+			ips = append(ips, ip)
+		}
+		if len(ips) > requested {
+			touched = append(touched, block)
+		}
+	}
+
+	for _, block := range sorted {
+		if len(ips) == n {
+			break
+		}
+		request(block)
+	}
+
+	for len(ips) < n {
+		block, err := ipam.nextBlock()
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "nextBlock failed")
+		}
+
+		requested := len(ips)
+		request(block)
+		if len(ips) == requested {
+			return nil, nil, nil, errorf(ErrExhausted, "ipam: no addresses available")
+		}
+	}
+
+	cmps := []storage.Cmp{}
+	ops := []storage.Op{}
+	for _, block := range touched {
+		cmps = append(cmps, block.Cmp()...)
+		ops = append(ops, block.PutOp()...)
+	}
+	return ips, cmps, ops, nil
+}
+
+// requestInRange requests addresses from the block until one is found within the
+// network, or the block is exhausted.
+func (ipam *etcdIPAM) requestInRange(block *ipamBlock) net.IP {
+	for block.Available() > 0 {
+		ip := block.Request()
+		if ip == nil {
+			return nil
+		}
+		if ipam.net.Contains(ip) && !ipam.isReserved(ip) {
+			return ip
+		}
+	}
+	return nil
+}
+
 func (ipam *etcdIPAM) newBlock(ip net.IP) *ipamEtcdBlock {
 	var block *ipamEtcdBlock
 	if len(ipam.net.IP) == net.IPv4len {
@@ -273,6 +395,16 @@ func (ipam *etcdIPAM) newBlock(ip net.IP) *ipamEtcdBlock {
 	return block
 }
 
+// blockContainsNet reports whether the allocation block starting at ip holds the whole
+// network, which is the case for networks smaller than the minimum block size.
+func (ipam *etcdIPAM) blockContainsNet(ip net.IP) bool {
+	mask := MinIPv6SubnetMask
+	if len(ipam.net.IP) == net.IPv4len {
+		mask = MinIPv4SubnetMask
+	}
+	return (&net.IPNet{IP: ip, Mask: mask}).Contains(ipam.net.IP)
+}
+
 func (ipam *etcdIPAM) incSubnet(ip net.IP) net.IP {
 	var next net.IP
 	if len(ipam.net.IP) == net.IPv4len {
@@ -285,21 +417,21 @@ func (ipam *etcdIPAM) incSubnet(ip net.IP) net.IP {
 	return next
 }
 
-func (ipam *etcdIPAM) commitNextBlock(block *ipamEtcdBlock, nextIP net.IP) (*etcd.TxnResponse, error) {
+func (ipam *etcdIPAM) commitNextBlock(block *ipamEtcdBlock, nextIP net.IP) (*storage.TxnResponse, error) {
 	blockBytes, err := json.Marshal(block.block)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := ipam.etcd.KV.Txn(context.Background()).If(
-		etcd.Compare(etcd.Version(block.key), "=", 0),
-		etcd.Compare(etcd.Value(path.Join(IpamEtcdKeyPrefix, ipam.ID, "nextKey")), "=", net.ParseIP(ipam.nextKey).String()),
+	resp, err := ipam.store.Txn(context.Background()).If(
+		storage.Compare(storage.Version(block.key), "=", 0),
+		storage.Compare(storage.Value(path.Join(IpamEtcdKeyPrefix, ipam.ID, "nextKey")), "=", net.ParseIP(ipam.nextKey).String()),
 	).Then(
-		etcd.OpPut(
+		storage.OpPut(
 			block.key,
 			string(blockBytes),
 		),
-		etcd.OpPut(
+		storage.OpPut(
 			path.Join(IpamEtcdKeyPrefix, ipam.ID, "nextKey"),
 			nextIP.String(),
 		),
@@ -319,7 +451,7 @@ func (ipam *etcdIPAM) nextBlock() (*ipamEtcdBlock, error) {
 
 	for !succeeded {
 		if !ipam.net.Contains(newNextIP) {
-			return nil, errors.New("no next allocation block available")
+			return nil, errorf(ErrExhausted, "no next allocation block available")
 		}
 
 		newNextIP = ipam.incSubnet(newNextIP)
@@ -331,6 +463,8 @@ func (ipam *etcdIPAM) nextBlock() (*ipamEtcdBlock, error) {
 
 		succeeded = resp.Succeeded
 	}
+	// the block was created by the commit, callers compare against its version
+	block.version = 1
 
 	ipam.nextKeyLock.Lock()
 	ipam.nextKey = newNextIP.String()
@@ -339,14 +473,6 @@ func (ipam *etcdIPAM) nextBlock() (*ipamEtcdBlock, error) {
 	return block, nil
 }
 
-func (ipam *etcdIPAM) maxAllocations() float64 {
-	ones, _ := ipam.net.Mask.Size()
-	if len(ipam.net.IP) == net.IPv4len {
-		return math.Pow(float64(2), float64(MinIPv4SubnetSize-ones))
-	}
-	return math.Pow(float64(2), float64(MinIPv6SubnetSize-ones))
-}
-
 func (ipam *etcdIPAM) allocateSubBlock(addresses uint, block *ipamBlock) []net.IP {
 	allocatedAddrs := []net.IP{}
 	addrs := block.BulkRequest(addresses)
@@ -361,7 +487,7 @@ func (ipam *etcdIPAM) allocateSubBlock(addresses uint, block *ipamBlock) []net.I
 }
 
 func (ipam *etcdIPAM) fetchIpamBlocks() (map[string]*ipamEtcdBlock, error) {
-	resp, err := ipam.etcd.KV.Get(context.Background(), path.Join(IpamEtcdKeyPrefix, ipam.ID, "allocations"), etcd.WithPrefix())
+	resp, err := ipam.store.Get(context.Background(), path.Join(IpamEtcdKeyPrefix, ipam.ID, "allocations"), storage.WithPrefix())
 	if err != nil {
 		return nil, err
 	}
@@ -380,14 +506,14 @@ func (ipam *etcdIPAM) fetchIpamBlocks() (map[string]*ipamEtcdBlock, error) {
 }
 
 func (ipam *etcdIPAM) fetchIpamBlock(addr string) (*ipamEtcdBlock, error) {
-	resp, err := ipam.etcd.KV.Get(context.Background(), path.Join(IpamEtcdKeyPrefix, ipam.ID, "allocations", addr))
+	resp, err := ipam.store.Get(context.Background(), path.Join(IpamEtcdKeyPrefix, ipam.ID, "allocations", addr))
 	if err != nil {
 		return nil, err
 	}
 	var etcdBlock *ipamEtcdBlock
 
-	if !ipam.net.Contains(net.ParseIP(addr)) {
-		return nil, errors.New("address out of range")
+	if !ipam.net.Contains(net.ParseIP(addr)) && !ipam.blockContainsNet(net.ParseIP(addr)) {
+		return nil, errorf(ErrInvalidArgument, "address out of range")
 	}
 
 	if len(resp.Kvs) == 0 {
@@ -398,10 +524,10 @@ func (ipam *etcdIPAM) fetchIpamBlock(addr string) (*ipamEtcdBlock, error) {
 			return nil, err
 		}
 
-		txnResp, err := ipam.etcd.KV.Txn(context.Background()).If(
-			etcd.Compare(etcd.Version(path.Join(IpamEtcdKeyPrefix, ipam.ID, "allocations", addr)), "=", 0),
+		txnResp, err := ipam.store.Txn(context.Background()).If(
+			storage.Compare(storage.Version(path.Join(IpamEtcdKeyPrefix, ipam.ID, "allocations", addr)), "=", 0),
 		).Then(
-			etcd.OpPut(
+			storage.OpPut(
 				path.Join(IpamEtcdKeyPrefix, ipam.ID, "allocations", addr),
 				string(blockBytes),
 			),
@@ -412,7 +538,7 @@ func (ipam *etcdIPAM) fetchIpamBlock(addr string) (*ipamEtcdBlock, error) {
 		}
 
 		if txnResp.Succeeded == false {
-			return nil, fmt.Errorf("ipam: failed to allocate block=%s", addr)
+			return nil, errorf(ErrConflict, "ipam: failed to allocate block=%s", addr)
 		}
 	} else {
 		block := &ipamBlock{}
@@ -427,72 +553,135 @@ func (ipam *etcdIPAM) fetchIpamBlock(addr string) (*ipamEtcdBlock, error) {
 }
 
 func (ipam *etcdIPAM) Release(ip net.IP) error {
-	var block *ipamEtcdBlock
-	var err error
+	retryCount := 0
 RELEASE:
-	if len(ipam.net.IP) == net.IPv4len {
-		block, err = ipam.fetchIpamBlock(ip.Mask(MinIPv4SubnetMask).String())
-	} else {
-		block, err = ipam.fetchIpamBlock(ip.Mask(MinIPv6SubnetMask).String())
-	}
+	cmps, ops, err := ipam.ReleaseOp(ip)
 	if err != nil {
 		return err
 	}
 
-	block.block.Release(ip)
-
-	resp, err := ipam.etcd.KV.Txn(context.TODO()).If(block.Cmp()...).Then(block.PutOp()...).Commit()
+	resp, err := ipam.store.Txn(context.TODO()).If(cmps...).Then(ops...).Commit()
 	if err != nil {
 		return err
 	}
 	if !resp.Succeeded {
-		//TODO:backoff/rety count
-		goto RELEASE
+		if retryCount < PostalIPAMRetryMax {
+			retryCount++
+			goto RELEASE
+		}
+		return errorf(ErrConflict, "ipam/release: too many conflicting updates")
 	}
 
 	return nil
 }
 
-func (ipam *etcdIPAM) Claim(ip net.IP) error {
-	var block *ipamEtcdBlock
-	var err error
-CLAIM:
-	if len(ipam.net.IP) == net.IPv4len {
-		block, err = ipam.fetchIpamBlock(ip.Mask(MinIPv4SubnetMask).String())
-	} else {
-		block, err = ipam.fetchIpamBlock(ip.Mask(MinIPv6SubnetMask).String())
+func (ipam *etcdIPAM) ReleaseOp(ip net.IP) ([]storage.Cmp, []storage.Op, error) {
+	if !ipam.net.Contains(ip) {
+		return nil, nil, errorf(ErrInvalidArgument, "address out of range")
 	}
+
+	block, err := ipam.fetchIpamBlock(ipam.blockAddr(ip))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	claimed := block.block.Claim(ip)
-	if !claimed {
-		return fmt.Errorf("ipam/claim: addr already claimed: %s", ip.String())
+	block.block.Release(ip)
+	return block.Cmp(), block.PutOp(), nil
+}
+
+func (ipam *etcdIPAM) Claim(ip net.IP) error {
+	retryCount := 0
+CLAIM:
+	cmps, ops, err := ipam.ClaimOp(ip)
+	if err != nil {
+		return err
 	}
 
-	resp, err := ipam.etcd.KV.Txn(context.TODO()).If(block.Cmp()...).Then(block.PutOp()...).Commit()
+	resp, err := ipam.store.Txn(context.TODO()).If(cmps...).Then(ops...).Commit()
 	if err != nil {
 		return err
 	}
 	if !resp.Succeeded {
-		//TODO:backoff/rety count
-		goto CLAIM
+		if retryCount < PostalIPAMRetryMax {
+			retryCount++
+			goto CLAIM
+		}
+		return errorf(ErrConflict, "ipam/claim: too many conflicting updates")
 	}
 
 	return nil
 }
 
+func (ipam *etcdIPAM) ClaimOp(ip net.IP) ([]storage.Cmp, []storage.Op, error) {
+	if !ipam.net.Contains(ip) {
+		return nil, nil, errorf(ErrInvalidArgument, "address out of range")
+	}
+
+	if ipam.isReserved(ip) {
+		return nil, nil, errorf(ErrInvalidArgument, "ipam/claim: addr is reserved: %s", ip.String())
+	}
+
+	block, err := ipam.fetchIpamBlock(ipam.blockAddr(ip))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	claimed := block.block.Claim(ip)
+	if !claimed {
+		return nil, nil, errorf(ErrAlreadyExists, "ipam/claim: addr already claimed: %s", ip.String())
+	}
+
+	return block.Cmp(), block.PutOp(), nil
+}
+
+// isReserved reports whether ip is the network or broadcast address of an ipv4 network,
+// neither of which are ever handed out.
+func (ipam *etcdIPAM) isReserved(ip net.IP) bool {
+	if ip.To4() == nil {
+		return false
+	}
+	return ip.Equal(ipam.net.IP) || ip.Equal(lastCIDRAddr(ipam.net))
+}
+
+// blockAddr returns the address of the allocation block that contains ip.
+func (ipam *etcdIPAM) blockAddr(ip net.IP) string {
+	if len(ipam.net.IP) == net.IPv4len {
+		return ip.Mask(MinIPv4SubnetMask).String()
+	}
+	return ip.Mask(MinIPv6SubnetMask).String()
+}
+
 func (ipam *etcdIPAM) IsAvailable(ip net.IP) bool {
 	return true
 }
 
 func (ipam *etcdIPAM) Size() uint64 {
-	return uint64(ipam.maxAllocations())
+	ones, bits := ipam.net.Mask.Size()
+	if bits-ones >= 64 {
+		return math.MaxUint64
+	}
+	return uint64(1) << uint(bits-ones)
 }
 
 func (ipam *etcdIPAM) Available() uint64 {
-	return uint64(0)
+	allocated, err := ipam.Allocated()
+	if err != nil || allocated > ipam.Size() {
+		return 0
+	}
+	return ipam.Size() - allocated
+}
+
+func (ipam *etcdIPAM) Allocated() (uint64, error) {
+	blocks, err := ipam.fetchIpamBlocks()
+	if err != nil {
+		return 0, errors.Wrap(err, "fetching ipam blocks failed")
+	}
+
+	allocated := uint64(0)
+	for _, block := range blocks {
+		allocated += uint64(block.block.allocated)
+	}
+	return allocated, nil
 }
 
 func (ipam *etcdIPAM) GetID() string {
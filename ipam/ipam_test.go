@@ -17,27 +17,22 @@ limitations under the License.
 package ipam
 
 import (
+	"math"
 	"net"
 	"testing"
-	"time"
 
 	"golang.org/x/net/context"
 
-	"github.com/coreos/etcd/clientv3"
+	"github.com/jive/postal/storage/storagetest"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestIPAMOutOfRangeClaim(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	i, err := NewIPAM("10.10.0.0/24", cli)
+	i, err := NewIPAM("10.10.0.0/24", store)
 	assert.NoError(err)
 
 	assert.Error(i.Claim(net.ParseIP("10.20.0.10")))
@@ -45,15 +40,10 @@ func TestIPAMOutOfRangeClaim(t *testing.T) {
 
 func TestIPAMFragmentedClaim(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	i, err := NewIPAM("10.10.0.0/22", cli)
+	i, err := NewIPAM("10.10.0.0/22", store)
 	assert.NoError(err)
 
 	_, err = i.Allocate(30)
@@ -69,15 +59,10 @@ func TestIPAMFragmentedClaim(t *testing.T) {
 
 func TestIPAMOutOfRangeClaimV6(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	i, err := NewIPAM("2001:db8::/112", cli)
+	i, err := NewIPAM("2001:db8::/112", store)
 	assert.NoError(err)
 
 	assert.Error(i.Claim(net.ParseIP("2001:db8:1::/112")))
@@ -85,15 +70,10 @@ func TestIPAMOutOfRangeClaimV6(t *testing.T) {
 
 func TestIPAMFragmentedClaimV6(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	i, err := NewIPAM("2001:db8::/110", cli)
+	i, err := NewIPAM("2001:db8::/110", store)
 	assert.NoError(err)
 
 	_, err = i.Allocate(30)
@@ -107,17 +87,76 @@ func TestIPAMFragmentedClaimV6(t *testing.T) {
 	assert.NoError(i.Claim(net.ParseIP("2001:db8::3:0001")))
 }
 
-func TestIPAM_IT(t *testing.T) {
+func TestIPAMUsage(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	i, err := NewIPAM("10.10.0.0/22", store)
+	assert.NoError(err)
+	assert.Equal(uint64(1024), i.Size())
+	assert.Equal(uint64(1024), i.Available())
+
+	_, err = i.Allocate(300)
+	assert.NoError(err)
+
+	// the network address of the first block is reserved
+	allocated, err := i.Allocated()
+	assert.NoError(err)
+	assert.Equal(uint64(301), allocated)
+	assert.Equal(uint64(723), i.Available())
+
+	v6, err := NewIPAM("fd00::/48", store)
+	assert.NoError(err)
+	assert.Equal(uint64(math.MaxUint64), v6.Size())
+}
+
+func TestIPAMAllocateNOp(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	i, err := NewIPAM("10.10.0.0/22", store)
+	assert.NoError(err)
+
+	// the addresses span a new block, which commits on the first try
+	ips, cmps, ops, err := i.AllocateNOp(300)
+	assert.NoError(err)
+	assert.Equal(300, len(ips))
+	assert.Equal("10.10.0.1", ips[0].String())
+	allocatedIPs := map[string]bool{}
+	for _, ip := range ips {
+		allocatedIPs[ip.String()] = true
+	}
+	assert.Equal(300, len(allocatedIPs))
+
+	resp, err := store.Txn(context.TODO()).If(cmps...).Then(ops...).Commit()
+	assert.NoError(err)
+	assert.True(resp.Succeeded)
+
+	allocated, err := i.Allocated()
 	assert.NoError(err)
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	assert.Equal(uint64(301), allocated)
+
+	// committed addresses are not handed out again
+	ips, _, _, err = i.AllocateNOp(2)
+	assert.NoError(err)
+	if assert.Equal(2, len(ips)) {
+		assert.False(allocatedIPs[ips[0].String()])
+		assert.False(allocatedIPs[ips[1].String()])
+		assert.NotEqual(ips[0].String(), ips[1].String())
+	}
+
+	_, _, _, err = i.AllocateNOp(1000)
+	assert.Error(err)
+}
+
+func TestIPAM_IT(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	i, err := NewIPAM("10.10.0.0/16", cli)
+	i, err := NewIPAM("10.10.0.0/16", store)
 	assert.NoError(err)
 
 	// Concurrent goroutines
@@ -0,0 +1,40 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	allocateRetries = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "postal",
+		Subsystem: "ipam",
+		Name:      "allocate_retries_total",
+		Help:      "Total number of times an address allocation was retried.",
+	})
+
+	allocateCASFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "postal",
+		Subsystem: "ipam",
+		Name:      "allocate_cas_failures_total",
+		Help:      "Total number of address allocation transactions that failed their compare.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(allocateRetries)
+	prometheus.MustRegister(allocateCASFailures)
+}
@@ -19,9 +19,30 @@ package ipam
 import (
 	"fmt"
 	"math"
+	"math/rand"
 	"net"
+	"time"
 )
 
+const (
+	retryBackoffBase = 5 * time.Millisecond
+	retryBackoffMax  = 500 * time.Millisecond
+)
+
+// RetryBackoff returns how long to wait before the given retry of a transaction
+// that lost its compare. The wait doubles with each retry up to retryBackoffMax,
+// and is jittered so that conflicting writers spread out.
+func RetryBackoff(retry int) time.Duration {
+	d := retryBackoffBase
+	for i := 1; i < retry && d < retryBackoffMax; i++ {
+		d *= 2
+	}
+	if d > retryBackoffMax {
+		d = retryBackoffMax
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
 func ipv4ToUint(addr net.IP) uint32 {
 	if addr.To4() == nil {
 		return 0
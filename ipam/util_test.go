@@ -3,6 +3,7 @@ package ipam
 import (
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -52,3 +53,16 @@ func TestIPv6ToUint(t *testing.T) {
 		assert.Equal(cases[idx].addr, uintToIPv6(a, b))
 	}
 }
+
+func TestRetryBackoff(t *testing.T) {
+	assert := assert.New(t)
+	last := time.Duration(0)
+	for retry := 1; retry <= PostalIPAMRetryMax; retry++ {
+		d := RetryBackoff(retry)
+		assert.True(d >= last/2, "retry %d waited %v after %v", retry, d, last)
+		assert.True(d <= retryBackoffMax, "retry %d waited %v", retry, d)
+		last = d
+	}
+	assert.True(RetryBackoff(1) <= retryBackoffBase)
+	assert.True(RetryBackoff(PostalIPAMRetryMax) >= retryBackoffMax/2)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+	"github.com/twinj/uuid"
+	"golang.org/x/net/context"
+)
+
+// PostalAuditKeyPrefix defines the prefix of the audit log, which is kept apart
+// from the registry so watches of the registry do not see it.
+const PostalAuditKeyPrefix = "/postal/audit/v1/"
+
+// auditTimeKey returns the key audit entries recorded at t sort after, entry
+// keys are ordered by the time they were recorded.
+func auditTimeKey(t int64) string {
+	return fmt.Sprintf("%s%019d", PostalAuditKeyPrefix, t)
+}
+
+func auditKey(entry *api.AuditEntry) string {
+	return auditTimeKey(entry.Time) + "/" + entry.ID
+}
+
+// AuditFilter selects audit entries, unset fields match every entry.
+type AuditFilter struct {
+	// Start and End bound the time entries were recorded at, in unix nanoseconds.
+	// End is excluded.
+	Start int64
+	End   int64
+
+	NetworkID string
+	PoolID    string
+	Address   string
+	Identity  string
+	Method    string
+}
+
+func (f *AuditFilter) match(entry *api.AuditEntry) bool {
+	if f == nil {
+		return true
+	}
+
+	switch {
+	case len(f.NetworkID) > 0 && f.NetworkID != entry.NetworkID:
+		return false
+	case len(f.PoolID) > 0 && f.PoolID != entry.PoolID:
+		return false
+	case len(f.Identity) > 0 && f.Identity != entry.Identity:
+		return false
+	case len(f.Method) > 0 && f.Method != entry.Method:
+		return false
+	}
+
+	if len(f.Address) > 0 {
+		addr := net.ParseIP(f.Address)
+		for _, a := range entry.Addresses {
+			if addr.Equal(net.ParseIP(a)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// AppendAudit records an audit entry, setting its ID and, if unset, its time.
+// The revision is recorded as given, it is the one of the change the entry is about.
+// Entries are never changed once recorded.
+func (config *Config) AppendAudit(entry *api.AuditEntry) error {
+	entry.ID = uuid.NewV4().String()
+	if entry.Time == 0 {
+		entry.Time = time.Now().UnixNano()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit entry")
+	}
+
+	key := auditKey(entry)
+	resp, err := config.store.Txn(context.TODO()).If(
+		storage.Compare(storage.Version(key), "=", 0),
+	).Then(
+		storage.OpPut(key, string(data)),
+	).Commit()
+	if err != nil {
+		return errors.Wrap(err, "etcd transaction error")
+	}
+
+	if !resp.Succeeded {
+		return errorf(ErrAlreadyExists, "audit entry %s already exists", entry.ID)
+	}
+
+	return nil
+}
+
+// AuditPage returns a page of the audit entries matching the filter in the order
+// they were recorded and the token of the next page.
+func (config *Config) AuditPage(filter *AuditFilter, page Page) ([]*api.AuditEntry, string, error) {
+	after, err := decodeToken(page.Token, PostalAuditKeyPrefix)
+	if err != nil {
+		return nil, "", err
+	}
+
+	end := storage.PrefixEnd(PostalAuditKeyPrefix)
+	if filter != nil {
+		if len(after) == 0 && filter.Start > 0 {
+			after = auditTimeKey(filter.Start)
+		}
+		if filter.End > 0 {
+			end = auditTimeKey(filter.End)
+		}
+	}
+
+	entries := []*api.AuditEntry{}
+	last, err := rangeKeysTo(config.store, PostalAuditKeyPrefix, after, end, page.Limit, func(kv *storage.KeyValue) (bool, error) {
+		entry := &api.AuditEntry{}
+		err := json.Unmarshal(kv.Value, entry)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to unmarshal audit entry")
+		}
+
+		if !filter.match(entry) {
+			return false, nil
+		}
+
+		entries = append(entries, entry)
+		return true, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return entries, encodeToken(last), nil
+}
+
+// PruneAudit deletes the audit entries recorded before t, in unix nanoseconds,
+// and returns their number.
+func (config *Config) PruneAudit(t int64) (int64, error) {
+	resp, err := config.store.Delete(context.TODO(), PostalAuditKeyPrefix, storage.WithRange(auditTimeKey(t)))
+	if err != nil {
+		return 0, errors.Wrap(err, "etcd kv delete failed")
+	}
+
+	return resp.Deleted, nil
+}
@@ -0,0 +1,69 @@
+package postal
+
+import (
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudit(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+
+	entries := []*api.AuditEntry{
+		{Time: 100, Revision: 11, Method: "NetworkAdd", Identity: "alice", NetworkID: "net1"},
+		{Time: 200, Revision: 12, Method: "BindAddress", Identity: "proxy", NetworkID: "net1", PoolID: "pool1", Addresses: []string{"10.0.0.7"}},
+		{Time: 300, Revision: 13, Method: "ReleaseAddress", Identity: "proxy", NetworkID: "net1", PoolID: "pool1", Addresses: []string{"10.0.0.7"}},
+		{Time: 400, Revision: 14, Method: "BindAddress", Identity: "bob", NetworkID: "net1", PoolID: "pool2", Addresses: []string{"10.0.0.7"}},
+	}
+	for _, entry := range entries {
+		assert.NoError(config.AppendAudit(entry))
+		assert.NotEmpty(entry.ID)
+	}
+
+	all, token, err := config.AuditPage(nil, Page{})
+	assert.NoError(err)
+	assert.Empty(token)
+	if assert.Equal(4, len(all)) {
+		for idx := range all {
+			assert.Equal(entries[idx].ID, all[idx].ID)
+			assert.Equal(entries[idx].Revision, all[idx].Revision)
+		}
+	}
+
+	ids := func(filter *AuditFilter) []int64 {
+		page, _, err := config.AuditPage(filter, Page{})
+		assert.NoError(err)
+		times := []int64{}
+		for _, entry := range page {
+			times = append(times, entry.Time)
+		}
+		return times
+	}
+	assert.Equal([]int64{200, 300, 400}, ids(&AuditFilter{Address: "10.0.0.7"}))
+	assert.Equal([]int64{200, 300}, ids(&AuditFilter{Address: "10.0.0.7", Start: 150, End: 400}))
+	assert.Equal([]int64{200, 400}, ids(&AuditFilter{Method: "BindAddress"}))
+	assert.Equal([]int64{400}, ids(&AuditFilter{Identity: "bob"}))
+	assert.Equal([]int64{200, 300}, ids(&AuditFilter{PoolID: "pool1"}))
+	assert.Equal([]int64{}, ids(&AuditFilter{NetworkID: "net2"}))
+
+	// pages continue where the previous one ended
+	page, token, err := config.AuditPage(&AuditFilter{Start: 200}, Page{Limit: 2})
+	assert.NoError(err)
+	assert.Equal(2, len(page))
+	assert.NotEmpty(token)
+	page, token, err = config.AuditPage(&AuditFilter{Start: 200}, Page{Limit: 2, Token: token})
+	assert.NoError(err)
+	assert.Equal(1, len(page))
+	assert.Equal(int64(400), page[0].Time)
+
+	deleted, err := config.PruneAudit(300)
+	assert.NoError(err)
+	assert.Equal(int64(2), deleted)
+	assert.Equal([]int64{300, 400}, ids(nil))
+}
@@ -3,12 +3,13 @@ package postal
 import (
 	"encoding/json"
 	"net"
-	"regexp"
-	"strings"
+	"path"
+	"strconv"
 	"time"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/jive/postal/api"
+	"github.com/jive/postal/ipam"
+	"github.com/jive/postal/storage"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 )
@@ -23,6 +24,11 @@ const (
 	HardRelease = 1
 )
 
+// bindingOp prepares additional conditions and operations for a binding write, such as
+// the ipam or lease side of it. It may set the binding's address if the address is
+// chosen by the ipam.
+type bindingOp func(*etcdBinding) ([]storage.Cmp, []storage.Op, error)
+
 type etcdBinding struct {
 	*api.Binding
 
@@ -33,13 +39,19 @@ func (b *etcdBinding) isBound() bool {
 	return b.BindTime > b.ReleaseTime
 }
 
+// isQuarantined reports whether the binding is released and its quarantine
+// lasts beyond now.
+func (b *etcdBinding) isQuarantined(now time.Time) bool {
+	return !b.isBound() && b.QuarantineUntil > now.UnixNano()
+}
+
 func (b *etcdBinding) annotate(key, value string) {
 	b.Annotations[key] = value
 }
 
-func (b *etcdBinding) etcdConditions() []clientv3.Cmp {
-	return []clientv3.Cmp{
-		clientv3.Compare(clientv3.Version(bindingIDKey(b.PoolID.NetworkID, b.PoolID.ID, b.ID)), "=", b.version),
+func (b *etcdBinding) etcdConditions() []storage.Cmp {
+	return []storage.Cmp{
+		storage.Compare(storage.Version(bindingIDKey(b.PoolID.NetworkID, b.PoolID.ID, b.ID)), "=", b.version),
 	}
 }
 
@@ -64,152 +76,418 @@ func newBinding(b *api.Binding) *etcdBinding {
 
 func (pm *etcdPoolManager) allocateBinding(binding *etcdBinding, addr net.IP) error {
 	if addr == nil || addr.IsUnspecified() {
-		return errors.New("must specify an address")
+		return errorf(ErrInvalidArgument, "must specify an address")
 	}
 
-	resp, err := pm.etcd.Get(context.Background(), bindingAddrKey(pm.pool.ID.NetworkID, addr))
+	resp, err := pm.store.Get(context.Background(), bindingAddrKey(pm.pool.ID.NetworkID, addr))
 	if err != nil {
 		return err
 	}
 
 	if len(resp.Kvs) != 0 {
-		return errors.New("address already allocated")
+		return errorf(ErrAlreadyExists, "address already allocated")
 	}
 	binding.AllocateTime = time.Now().UTC().UnixNano()
 	binding.Address = addr.String()
 
-	return pm.writeBinding(binding, NoTTL)
+	return pm.writeBinding(binding, NoTTL, chainOps(pm.growOp(1), pm.claimOp()))
 }
 
-func (pm *etcdPoolManager) bindBinding(binding *etcdBinding, addr net.IP) error {
+func (pm *etcdPoolManager) bindBinding(binding *etcdBinding, addr net.IP, ttl int64, o *bindOptions) error {
 	timestamp := time.Now().UTC().UnixNano()
 	if binding.AllocateTime == 0 {
 		binding.AllocateTime = timestamp
 	}
 	binding.BindTime = timestamp
 	binding.Address = addr.String()
-	return pm.writeBinding(binding, NoTTL)
+	binding.Ttl = ttl
+	return pm.writeBinding(binding, NoTTL, chainOps(pm.growOp(1), pm.claimOp(), pm.leaseOp(ttl), pm.ownerOp(o), historyOp))
+}
+
+// bindNextBinding binds the next free address of the network.
+func (pm *etcdPoolManager) bindNextBinding(binding *etcdBinding, ttl int64, o *bindOptions) error {
+	if pm.ipam == nil {
+		return errors.New("network has no ipam to allocate from")
+	}
+	timestamp := time.Now().UTC().UnixNano()
+	binding.AllocateTime = timestamp
+	binding.BindTime = timestamp
+	binding.Ttl = ttl
+	return pm.writeBinding(binding, NoTTL, chainOps(pm.growOp(1), pm.allocateOp(), pm.leaseOp(ttl), pm.ownerOp(o), historyOp))
 }
 
-func (pm *etcdPoolManager) rebindBinding(binding *etcdBinding, annotations map[string]string) error {
+func (pm *etcdPoolManager) rebindBinding(binding *etcdBinding, annotations map[string]string, ttl int64, o *bindOptions) error {
 	binding.Binding.Annotations = annotations
+	binding.Binding.AffinityKey = o.affinityKey
+	binding.Binding.Owner = o.owner
 	binding.Binding.BindTime = time.Now().UTC().UnixNano()
-	return pm.writeBinding(binding, NoTTL)
+	binding.Binding.Ttl = ttl
+	binding.Binding.QuarantineUntil = 0
+	return pm.writeBinding(binding, NoTTL, chainOps(pm.leaseOp(ttl), pm.ownerOp(o), historyOp))
 }
 
 func (pm *etcdPoolManager) releaseBinding(binding *etcdBinding, ttl int64) error {
+	// only the release of a bound binding ends a tenancy of its address
+	var history bindingOp
+	wasBound := binding.isBound()
+	if wasBound {
+		history = historyOp
+	}
+
 	binding.ReleaseTime = time.Now().UTC().UnixNano()
-	return pm.writeBinding(binding, ttl)
+	binding.Ttl = NoTTL
+	if wasBound && pm.pool.Quarantine > 0 {
+		binding.QuarantineUntil = binding.ReleaseTime + pm.pool.Quarantine*int64(time.Second)
+	}
+	if ttl == HardRelease {
+		return pm.writeBinding(binding, ttl, chainOps(pm.releaseOp(), pm.leaseOp(NoTTL), pm.disownOp, history))
+	}
+	return pm.writeBinding(binding, ttl, chainOps(pm.leaseOp(NoTTL), history))
 }
 
-func (pm *etcdPoolManager) writeBinding(binding *etcdBinding, ttl int64) error {
-	data, err := json.Marshal(binding)
+// renewBinding keeps the lease of a bound binding alive for another ttl period.
+func (pm *etcdPoolManager) renewBinding(binding *etcdBinding) error {
+	resp, err := pm.store.Get(context.TODO(), bindingLeaseKey(binding.PoolID.NetworkID, binding.PoolID.ID, binding.ID))
 	if err != nil {
-		return errors.Wrap(err, "marshalling binding failed")
+		return errors.Wrap(err, "etcd kv get failed")
 	}
 
-	putOpOptions := []clientv3.OpOption{}
-	if ttl > NoTTL {
-		resp, err := pm.etcd.Lease.Grant(context.TODO(), ttl)
+	if len(resp.Kvs) == 0 || resp.Kvs[0].Lease == 0 {
+		return errorf(ErrFailedPrecondition, "binding lease expired")
+	}
+
+	err = pm.store.KeepAliveOnce(context.TODO(), storage.LeaseID(resp.Kvs[0].Lease))
+	if err != nil {
+		return errors.Wrap(err, "lease keep alive failed")
+	}
+
+	return nil
+}
+
+// chainOps combines several bindingOps into one, applying them in order.
+// nil ops are skipped.
+func chainOps(ops ...bindingOp) bindingOp {
+	return func(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+		cmps := []storage.Cmp{}
+		txnOps := []storage.Op{}
+		for _, op := range ops {
+			if op == nil {
+				continue
+			}
+			c, o, err := op(binding)
+			if err != nil {
+				return nil, nil, err
+			}
+			cmps = append(cmps, c...)
+			txnOps = append(txnOps, o...)
+		}
+		return cmps, txnOps, nil
+	}
+}
+
+// claimOp returns the bindingOp used to claim a new address for the pool, if the
+// pool's network is backed by ipam.
+func (pm *etcdPoolManager) claimOp() bindingOp {
+	if pm.ipam == nil {
+		return nil
+	}
+	return func(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+		return pm.ipam.ClaimOp(net.ParseIP(binding.Address))
+	}
+}
+
+// releaseOp returns the bindingOp used to return an address to the pool's network.
+func (pm *etcdPoolManager) releaseOp() bindingOp {
+	if pm.ipam == nil {
+		return nil
+	}
+	return func(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+		return pm.ipam.ReleaseOp(net.ParseIP(binding.Address))
+	}
+}
+
+// allocateOp returns the bindingOp used to take the next free address from the pool's network.
+func (pm *etcdPoolManager) allocateOp() bindingOp {
+	if pm.ipam == nil {
+		return nil
+	}
+	return func(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+		addr, cmps, ops, err := pm.ipam.AllocateOp()
 		if err != nil {
-			return errors.Wrap(err, "creating lease failed")
+			return nil, nil, err
 		}
+		binding.Address = addr.String()
+		return cmps, ops, nil
+	}
+}
 
-		putOpOptions = append(putOpOptions, clientv3.WithLease(resp.ID))
+// growOp returns the bindingOp guarding the growth of the pool by n addresses,
+// see growOps.
+func (pm *etcdPoolManager) growOp(n int) bindingOp {
+	return func(*etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+		return pm.growOps(n)
 	}
+}
 
-	var ops []clientv3.Op
-	if ttl == HardRelease {
-		ops = []clientv3.Op{
-			clientv3.OpDelete(bindingAddrKey(binding.PoolID.NetworkID, net.ParseIP(binding.Address))),
-			clientv3.OpDelete(bindingIDKey(pm.pool.ID.NetworkID, pm.pool.ID.ID, binding.ID)),
+// growOps checks that the pool has room for another n addresses under the maximum
+// it is stored with. The returned comparisons fail the transaction if the pool is
+// updated, removed or grows in the meantime, so concurrent binds cannot take the
+// pool past its maximum.
+func (pm *etcdPoolManager) growOps(n int) ([]storage.Cmp, []storage.Op, error) {
+	metaKey := poolMetaKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)
+	resp, err := pm.store.Get(context.TODO(), metaKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "etcd kv get failed")
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, nil, errorf(ErrNotFound, "pool %s no longer exists", pm.pool.ID.ID)
+	}
+
+	pool := &api.Pool{}
+	err = json.Unmarshal(resp.Kvs[0].Value, pool)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal pool")
+	}
+
+	sizeVersion, size, err := pm.size()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if size+uint64(n) > pool.MaximumAddresses {
+		return nil, nil, errorf(ErrExhausted, "maximum addresses reached")
+	}
+
+	sizeKey := poolSizeKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)
+	cmps := []storage.Cmp{
+		storage.Compare(storage.Version(metaKey), "=", resp.Kvs[0].Version),
+		storage.Compare(storage.Version(sizeKey), "=", sizeVersion),
+	}
+	return cmps, []storage.Op{storage.OpPut(sizeKey, strconv.FormatUint(size+uint64(n), 10))}, nil
+}
+
+// leaseOp returns the bindingOp which attaches a lease of ttl seconds to the binding.
+// A ttl of NoTTL removes any lease left over from a previous bind. The lease is granted
+// once, so retried writes reuse it.
+func (pm *etcdPoolManager) leaseOp(ttl int64) bindingOp {
+	var lease storage.LeaseID
+	return pm.sharedLeaseOp(ttl, &lease)
+}
+
+// sharedLeaseOp is the leaseOp of bindings sharing one lease, which is granted into
+// lease by the first of them. The caller revokes it if the bindings are not written.
+func (pm *etcdPoolManager) sharedLeaseOp(ttl int64, lease *storage.LeaseID) bindingOp {
+	return func(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+		key := bindingLeaseKey(binding.PoolID.NetworkID, binding.PoolID.ID, binding.ID)
+		if ttl <= NoTTL {
+			return nil, []storage.Op{storage.OpDelete(key)}, nil
 		}
-	} else {
-		ops = []clientv3.Op{
-			clientv3.OpPut(
-				bindingAddrKey(binding.PoolID.NetworkID, net.ParseIP(binding.Address)),
-				bindingIDKey(binding.PoolID.NetworkID, binding.PoolID.ID, binding.ID), putOpOptions...),
-			clientv3.OpPut(bindingIDKey(
-				pm.pool.ID.NetworkID,
-				pm.pool.ID.ID,
-				binding.ID,
-			), string(data), putOpOptions...),
+
+		if *lease == storage.NoLease {
+			var err error
+			*lease, err = pm.store.Grant(context.TODO(), ttl)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "creating lease failed")
+			}
 		}
+
+		return nil, []storage.Op{
+			storage.OpPut(key, bindingIDKey(binding.PoolID.NetworkID, binding.PoolID.ID, binding.ID), storage.WithLease(*lease)),
+		}, nil
 	}
+}
 
-	res, err := pm.etcd.KV.Txn(context.TODO()).If(binding.etcdConditions()...).Then(ops...).Commit()
+// ownerOp indexes the binding under the owner of the bind, failing the
+// transaction if the owner's index changed since it was looked up.
+func (pm *etcdPoolManager) ownerOp(o *bindOptions) bindingOp {
+	if o.owner == "" {
+		return nil
+	}
+	return func(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+		key := ownerKey(binding.PoolID.NetworkID, binding.PoolID.ID, o.owner)
+		cmp := storage.Compare(storage.Version(key), "=", o.ownerVersion)
+		return []storage.Cmp{cmp}, []storage.Op{storage.OpPut(key, binding.ID)}, nil
+	}
+}
 
-	if err != nil {
-		return errors.Wrap(err, "etcd transaction error")
+// disownOp drops the owner index of a hard released binding, unless the owner
+// has since moved on to another binding.
+func (pm *etcdPoolManager) disownOp(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+	if binding.Owner == "" {
+		return nil, nil, nil
 	}
 
-	if !res.Succeeded {
-		return errors.New("etcd transaction failed")
+	key := ownerKey(binding.PoolID.NetworkID, binding.PoolID.ID, binding.Owner)
+	resp, err := pm.store.Get(context.TODO(), key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "etcd kv get failed")
+	}
+	if len(resp.Kvs) == 0 || string(resp.Kvs[0].Value) != binding.ID {
+		return nil, nil, nil
 	}
 
-	return nil
+	cmp := storage.Compare(storage.Version(key), "=", resp.Kvs[0].Version)
+	return []storage.Cmp{cmp}, []storage.Op{storage.OpDelete(key)}, nil
 }
 
-func (pm *etcdPoolManager) listBindings(filters map[string]string) ([]*etcdBinding, error) {
-	resp, err := pm.etcd.KV.Get(context.Background(), bindingListKey(pm.pool.ID.NetworkID, pm.pool.ID.ID), clientv3.WithPrefix())
+// historyOp records the binding in the history of its address, under the time it
+// was bound. It must follow the ops choosing the address.
+func historyOp(binding *etcdBinding) ([]storage.Cmp, []storage.Op, error) {
+	data, err := json.Marshal(binding.Binding)
 	if err != nil {
-		return nil, errors.Wrap(err, "etcd kv range failed")
+		return nil, nil, errors.Wrap(err, "marshalling binding failed")
 	}
 
-	noFilter := filters == nil || len(filters) == 0
+	key := historyKey(binding.PoolID.NetworkID, net.ParseIP(binding.Address), binding.BindTime, binding.ID)
+	return nil, []storage.Op{storage.OpPut(key, string(data))}, nil
+}
 
-	bindings := []*etcdBinding{}
-	for idx := range resp.Kvs {
-		binding := &api.Binding{}
-		json.Unmarshal(resp.Kvs[idx].Value, binding)
+// writeBinding persists the binding, applying op in the same transaction.
+// If op is nil, only the binding keys are written.
+func (pm *etcdPoolManager) writeBinding(binding *etcdBinding, ttl int64, op bindingOp) error {
+	putOpOptions := []storage.OpOption{}
+	if ttl > NoTTL {
+		lease, err := pm.store.Grant(context.TODO(), ttl)
+		if err != nil {
+			return errors.Wrap(err, "creating lease failed")
+		}
 
-		if noFilter {
-			bindings = append(bindings, &etcdBinding{binding, resp.Kvs[idx].Version})
-		} else {
-			var matched bool
-			for field, filter := range filters {
-				switch field {
-				case "_id":
-					matched, err = regexp.MatchString(filter, binding.ID)
-				case "_pool":
-					matched, err = regexp.MatchString(filter, binding.PoolID.ID)
-				case "_network":
-					matched, err = regexp.MatchString(filter, binding.PoolID.NetworkID)
-				case "_address":
-					matched, err = regexp.MatchString(filter, binding.Address)
-				default:
-					if val, ok := binding.Annotations[field]; ok {
-						matched, err = regexp.MatchString(filter, val)
-					} else {
-						break
-					}
-				}
-				if err != nil {
-					return nil, errors.Wrapf(err, "failed to compile filter '%s'", filter)
-				}
-
-				if !matched {
-					break
-				}
+		putOpOptions = append(putOpOptions, storage.WithLease(lease))
+	}
+
+	for retry := 0; ; retry++ {
+		cmps := binding.etcdConditions()
+		var extraOps []storage.Op
+		if op != nil {
+			extraCmps, ops, err := op(binding)
+			if err != nil {
+				return errors.Wrap(err, "preparing binding write failed")
 			}
+			cmps = append(cmps, extraCmps...)
+			extraOps = ops
+		}
 
-			if matched {
-				bindings = append(bindings, &etcdBinding{binding, resp.Kvs[idx].Version})
+		data, err := json.Marshal(binding)
+		if err != nil {
+			return errors.Wrap(err, "marshalling binding failed")
+		}
+
+		var ops []storage.Op
+		if ttl == HardRelease {
+			ops = []storage.Op{
+				storage.OpDelete(bindingAddrKey(binding.PoolID.NetworkID, net.ParseIP(binding.Address))),
+				storage.OpDelete(bindingIDKey(pm.pool.ID.NetworkID, pm.pool.ID.ID, binding.ID)),
 			}
+		} else {
+			ops = pm.putBindingOps(binding, data, putOpOptions...)
+		}
+		ops = append(ops, extraOps...)
+
+		res, err := pm.store.Txn(context.TODO()).If(cmps...).Then(ops...).Commit()
+
+		if err != nil {
+			return errors.Wrap(err, "etcd transaction error")
+		}
+
+		if res.Succeeded {
+			return nil
+		}
+		bindingCASFailures.Inc()
+
+		// A concurrent update to another address in the same ipam block fails the
+		// transaction as well, so retry for as long as the binding itself is unchanged.
+		if op == nil || retry >= ipam.PostalIPAMRetryMax || !pm.bindingUnchanged(binding) {
+			return errorf(ErrConflict, "etcd transaction failed")
+		}
+		bindingTxnRetries.Inc()
+	}
+}
+
+// putBindingOps returns the ops writing the address and ID keys of the binding,
+// data is the marshalled binding.
+func (pm *etcdPoolManager) putBindingOps(binding *etcdBinding, data []byte, opts ...storage.OpOption) []storage.Op {
+	return []storage.Op{
+		storage.OpPut(
+			bindingAddrKey(binding.PoolID.NetworkID, net.ParseIP(binding.Address)),
+			bindingIDKey(binding.PoolID.NetworkID, binding.PoolID.ID, binding.ID), opts...),
+		storage.OpPut(bindingIDKey(
+			pm.pool.ID.NetworkID,
+			pm.pool.ID.ID,
+			binding.ID,
+		), string(data), opts...),
+	}
+}
+
+// writeBindings persists bound bindings in a single transaction, along with the
+// given cmps and ops claiming their addresses. It reports whether the
+// transaction committed.
+func (pm *etcdPoolManager) writeBindings(bindings []*etcdBinding, leaseOp bindingOp, cmps []storage.Cmp, ops []storage.Op) (bool, error) {
+	for _, binding := range bindings {
+		extraCmps, extraOps, err := chainOps(leaseOp, historyOp)(binding)
+		if err != nil {
+			return false, errors.Wrap(err, "preparing binding write failed")
+		}
+
+		data, err := json.Marshal(binding)
+		if err != nil {
+			return false, errors.Wrap(err, "marshalling binding failed")
+		}
+
+		cmps = append(cmps, binding.etcdConditions()...)
+		cmps = append(cmps, extraCmps...)
+		ops = append(ops, pm.putBindingOps(binding, data)...)
+		ops = append(ops, extraOps...)
+	}
+
+	res, err := pm.store.Txn(context.TODO()).If(cmps...).Then(ops...).Commit()
+	if err != nil {
+		return false, errors.Wrap(err, "etcd transaction error")
+	}
+	return res.Succeeded, nil
+}
+
+func (pm *etcdPoolManager) bindingUnchanged(binding *etcdBinding) bool {
+	resp, err := pm.store.Get(context.TODO(), bindingIDKey(binding.PoolID.NetworkID, binding.PoolID.ID, binding.ID))
+	if err != nil {
+		return false
+	}
+
+	if len(resp.Kvs) == 0 {
+		return binding.version == 0
+	}
+
+	return resp.Kvs[0].Version == binding.version
+}
+
+func (pm *etcdPoolManager) listBindings(sel *Selector) ([]*etcdBinding, error) {
+	bindings := []*etcdBinding{}
+	_, err := rangeKeys(pm.store, bindingListKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)+"/", "", 0, func(kv *storage.KeyValue) (bool, error) {
+		binding := &api.Binding{}
+		json.Unmarshal(kv.Value, binding)
+
+		if !sel.MatchBinding(binding) {
+			return false, nil
 		}
 
+		bindings = append(bindings, &etcdBinding{binding, kv.Version})
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
 	return bindings, nil
 }
 
 func (pm *etcdPoolManager) getBinding(ID string) (*etcdBinding, error) {
-	resp, err := pm.etcd.KV.Get(context.Background(), bindingIDKey(pm.pool.ID.NetworkID, pm.pool.ID.ID, ID))
+	resp, err := pm.store.Get(context.Background(), bindingIDKey(pm.pool.ID.NetworkID, pm.pool.ID.ID, ID))
 	if err != nil {
 		return nil, errors.Wrap(err, "etcd kv get failed")
 	}
 
 	if len(resp.Kvs) == 0 {
-		return nil, errors.Errorf("failed to get binding for ID (%s)", ID)
+		return nil, errorf(ErrNotFound, "failed to get binding for ID (%s)", ID)
 	}
 
 	binding := &api.Binding{}
@@ -217,32 +495,87 @@ func (pm *etcdPoolManager) getBinding(ID string) (*etcdBinding, error) {
 	return &etcdBinding{binding, resp.Kvs[0].Version}, nil
 }
 
+// ownedBinding returns the binding o's owner last bound in the pool, or nil if
+// there is none or its address has since been bound for someone else. The
+// version of the owner's index key is recorded in o.
+func (pm *etcdPoolManager) ownedBinding(o *bindOptions) (*etcdBinding, error) {
+	resp, err := pm.store.Get(context.TODO(), ownerKey(pm.pool.ID.NetworkID, pm.pool.ID.ID, o.owner))
+	if err != nil {
+		return nil, errors.Wrap(err, "etcd kv get failed")
+	}
+
+	if len(resp.Kvs) == 0 {
+		o.ownerVersion = 0
+		return nil, nil
+	}
+	o.ownerVersion = resp.Kvs[0].Version
+
+	binding, err := pm.getBinding(string(resp.Kvs[0].Value))
+	if ErrorKindOf(err) == ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if binding.Owner != o.owner {
+		return nil, nil
+	}
+	return binding, nil
+}
+
+// bindOwned returns the binding o's owner holds, binding the owner's previous
+// address again if it was released. It returns nil if the owner holds no
+// binding, or when addr is given, a released binding of another address.
+func (pm *etcdPoolManager) bindOwned(annotations map[string]string, addr net.IP, ttl int64, o *bindOptions) (*api.Binding, error) {
+	owned, err := pm.ownedBinding(o)
+	if err != nil || owned == nil {
+		return nil, err
+	}
+
+	if addr != nil && !net.ParseIP(owned.Address).Equal(addr) {
+		if owned.isBound() {
+			return nil, errorf(ErrAlreadyExists, "bind failed: owner already holds %s", owned.Address)
+		}
+		return nil, nil
+	}
+
+	if owned.isBound() {
+		return owned.Binding, nil
+	}
+
+	err = pm.rebindBinding(owned, annotations, ttl, o)
+	if err != nil {
+		return nil, errors.Wrap(err, "rebinding address of owner failed")
+	}
+	return owned.Binding, nil
+}
+
 func (pm *etcdPoolManager) getBindingForAddr(addr net.IP) (*etcdBinding, error) {
-	resp, err := pm.etcd.KV.Get(context.Background(), bindingAddrKey(pm.pool.ID.NetworkID, addr))
+	resp, err := pm.store.Get(context.Background(), bindingAddrKey(pm.pool.ID.NetworkID, addr))
 	if err != nil {
 		return nil, errors.Wrap(err, "etcd kv get failed")
 	}
 
 	if len(resp.Kvs) == 0 {
-		return nil, errors.Errorf("failed to get binding for addr (%s)", addr.String())
+		return nil, errorf(ErrNotFound, "failed to get binding for addr (%s)", addr.String())
 	}
 
-	bindingKey := string(resp.Kvs[0].Value)
-	return pm.getBinding(strings.Split(bindingKey, "/")[7])
+	return pm.getBinding(path.Base(string(resp.Kvs[0].Value)))
 }
 
 func (nm *etcdNetworkManager) getBindingForAddr(addr net.IP) (*etcdBinding, error) {
-	resp, err := nm.etcd.KV.Get(context.Background(), bindingAddrKey(nm.ID, addr))
+	resp, err := nm.store.Get(context.Background(), bindingAddrKey(nm.ID, addr))
 	if err != nil {
 		return nil, errors.Wrap(err, "etcd kv get failed")
 	}
 
 	if len(resp.Kvs) == 0 {
-		return nil, errors.Errorf("failed to get binding for addr (%s)", addr.String())
+		return nil, errorf(ErrNotFound, "failed to get binding for addr (%s)", addr.String())
 	}
 
 	bindingKey := string(resp.Kvs[0].Value)
-	resp, err = nm.etcd.KV.Get(context.Background(), bindingKey)
+	resp, err = nm.store.Get(context.Background(), bindingKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "etcd kv get failed")
 	}
@@ -18,12 +18,14 @@ package postal
 
 import (
 	"encoding/json"
-
-	"regexp"
+	"path"
+	"strings"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/pkg/capnslog"
 	"github.com/jive/postal/api"
+	"github.com/jive/postal/ipam"
+	"github.com/jive/postal/storage"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 )
@@ -37,12 +39,17 @@ var (
 
 // Config is the base object which configures settings for postal internals
 type Config struct {
-	etcd *clientv3.Client
+	store storage.Store
 }
 
 // WithEtcdClient is chaining method to set the etcdClient
 func (config *Config) WithEtcdClient(etcd *clientv3.Client) *Config {
-	config.etcd = etcd
+	return config.WithStore(storage.NewEtcdStore(etcd))
+}
+
+// WithStore is chaining method to set the storage backend
+func (config *Config) WithStore(store storage.Store) *Config {
+	config.store = store
 	return config
 }
 
@@ -50,95 +57,110 @@ type etcdNetworkMeta struct {
 	ID          string            `json:"id"`
 	Cidr        string            `json:"cidr"`
 	Annotations map[string]string `json:"annotations"`
+	IpamID      string            `json:"ipamID"`
+}
+
+// Networks returns a list of networks matching the selector
+func (config *Config) Networks(sel *Selector) ([]*api.Network, error) {
+	networks, _, err := config.NetworksPage(sel, Page{})
+	return networks, err
 }
 
-// Networks returns a list of filtered networks
-func (config *Config) Networks(filters map[string]string) ([]*api.Network, error) {
-	resp, err := config.etcd.Get(context.TODO(), networksKey(), clientv3.WithPrefix())
+// NetworksPage returns a page of the networks matching the selector and the token
+// of the next page.
+func (config *Config) NetworksPage(sel *Selector, page Page) ([]*api.Network, string, error) {
+	after, err := decodeToken(page.Token, networksKey()+"/")
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	noFilter := filters == nil || len(filters) == 0
-
 	networks := []*api.Network{}
-	for idx := range resp.Kvs {
+	last, err := rangeKeys(config.store, networksKey()+"/", after, page.Limit, func(kv *storage.KeyValue) (bool, error) {
 		network := &api.Network{}
-		err = json.Unmarshal(resp.Kvs[idx].Value, network)
+		err := json.Unmarshal(kv.Value, network)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to unmarshal network")
+			return false, errors.Wrap(err, "failed to unmarshal network")
 		}
 
-		if noFilter {
-			networks = append(networks, network)
-		} else {
-			var matched bool
-			for field, filter := range filters {
-				switch field {
-				case "_id":
-					matched, err = regexp.MatchString(filter, network.ID)
-				case "_cidr":
-					matched, err = regexp.MatchString(filter, network.Cidr)
-				default:
-					if val, ok := network.Annotations[field]; ok {
-						matched, err = regexp.MatchString(filter, val)
-					} else {
-						break
-					}
-				}
-				if err != nil {
-					return nil, errors.Wrapf(err, "failed to compile filter '%s'", filter)
-				}
-
-				if !matched {
-					break
-				}
-			}
-
-			if matched {
-				networks = append(networks, network)
-			}
+		if !sel.MatchNetwork(network) {
+			return false, nil
 		}
+
+		networks = append(networks, network)
+		return true, nil
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return networks, nil
+	return networks, encodeToken(last), nil
 }
 
-// Pools returns a list of filtered pools
-func (config *Config) Pools(filters map[string]string) ([]*api.Pool, error) {
+// Pools returns a list of pools matching the selector
+func (config *Config) Pools(sel *Selector) ([]*api.Pool, error) {
+	pools, _, err := config.PoolsPage(sel, Page{})
+	return pools, err
+}
+
+// PoolsPage returns a page of the pools matching the selector across all networks
+// and the token of the next page.
+func (config *Config) PoolsPage(sel *Selector, page Page) ([]*api.Pool, string, error) {
+	after, err := decodeToken(page.Token, path.Join(PostalEtcdKeyPrefix, "network")+"/")
+	if err != nil {
+		return nil, "", err
+	}
+
+	// pools are ranged network by network, the token holds the key of the last pool
+	var afterNetwork string
+	if len(after) > 0 {
+		afterNetwork = strings.Split(strings.TrimPrefix(after, PostalEtcdKeyPrefix), "/")[1]
+	}
+
 	networks, err := config.Networks(nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	pools := []*api.Pool{}
-
 	for idx := range networks {
-		nm, err := config.Network(networks[idx].ID)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get network")
+		if networks[idx].ID < afterNetwork {
+			continue
+		}
+
+		from := ""
+		if networks[idx].ID == afterNetwork {
+			from = after
+		}
+
+		limit := 0
+		if page.Limit > 0 {
+			limit = page.Limit - len(pools)
 		}
 
-		p, err := nm.Pools(filters)
+		nm := &etcdNetworkManager{ID: networks[idx].ID, store: config.store}
+		p, last, err := nm.poolsAfter(sel, from, limit)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get network")
+			return nil, "", errors.Wrapf(err, "failed to get pools of network %s", networks[idx].ID)
 		}
 
 		pools = append(pools, p...)
+		if len(last) > 0 {
+			return pools, encodeToken(last), nil
+		}
 	}
 
-	return pools, nil
+	return pools, "", nil
 }
 
 // Network returns a specific NetworkManager for a given ID
 func (config *Config) Network(ID string) (NetworkManager, error) {
-	resp, err := config.etcd.Get(context.TODO(), networkMetaKey(ID))
+	resp, err := config.store.Get(context.TODO(), networkMetaKey(ID))
 	if err != nil {
 		return nil, err
 	}
 
 	if len(resp.Kvs) != 1 {
-		return nil, errors.New("postal: network could not be found")
+		return nil, errorf(ErrNotFound, "postal: network could not be found")
 	}
 
 	network := &etcdNetworkMeta{}
@@ -147,35 +169,55 @@ func (config *Config) Network(ID string) (NetworkManager, error) {
 		return nil, err
 	}
 
-	return &etcdNetworkManager{
+	nm := &etcdNetworkManager{
 		ID:          network.ID,
 		cidr:        network.Cidr,
 		annotations: network.Annotations,
-		etcd:        config.etcd,
-	}, nil
+		store:       config.store,
+	}
+
+	// networks created before ipam was wired in have no backing allocator
+	if len(network.IpamID) == 0 {
+		plog.Warningf("network %s has no ipam, addresses will not be checked against its cidr", network.ID)
+		return nm, nil
+	}
+
+	nm.ipam, err = ipam.FetchIPAM(network.IpamID, config.store)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch ipam for network %s", network.ID)
+	}
+
+	return nm, nil
 }
 
 // NewNetwork creates a new NetworkManager for the given block of addresses.
 func (config *Config) NewNetwork(annotations map[string]string, cidr string) (NetworkManager, error) {
+	addrs, err := ipam.NewIPAM(cidr, config.store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ipam")
+	}
+
 	network := &etcdNetworkMeta{
 		ID:          newNetworkID(),
 		Cidr:        cidr,
 		Annotations: annotations,
+		IpamID:      addrs.GetID(),
 	}
 
 	networkBytes, err := json.Marshal(network)
 	if err != nil {
+		config.deleteIPAM(addrs.GetID())
 		return nil, err
 	}
 
-	_, err = config.etcd.KV.Put(
+	_, err = config.store.Put(
 		context.TODO(),
 		networkMetaKey(network.ID),
 		string(networkBytes),
 	)
 
 	if err != nil {
-		//TODO: cleanup IPAM
+		config.deleteIPAM(addrs.GetID())
 		return nil, err
 	}
 
@@ -183,6 +225,97 @@ func (config *Config) NewNetwork(annotations map[string]string, cidr string) (Ne
 		ID:          network.ID,
 		cidr:        cidr,
 		annotations: annotations,
-		etcd:        config.etcd,
+		store:       config.store,
+		ipam:        addrs,
 	}, nil
 }
+
+// RemoveNetwork deletes the network, all of its pools and its backing ipam.
+// Unless force is set, a network with bound addresses in any pool is not removed.
+// The network refuses new pools while it is removed. Should the removal stop midway,
+// for instance when the server exits, it is finished by removing the network again.
+func (config *Config) RemoveNetwork(ID string, force bool) error {
+	network, err := config.Network(ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get network")
+	}
+	nm := network.(*etcdNetworkManager)
+
+	resp, err := config.store.Txn(context.TODO()).If(
+		storage.Compare(storage.Version(networkMetaKey(ID)), ">", 0),
+	).Then(
+		storage.OpPut(networkRemovingKey(ID), ""),
+	).Commit()
+	if err != nil {
+		return errors.Wrap(err, "etcd transaction error")
+	}
+
+	if !resp.Succeeded {
+		return errorf(ErrNotFound, "postal: network could not be found")
+	}
+
+	err = config.removeNetwork(nm, force)
+	if err != nil {
+		_, derr := config.store.Delete(context.TODO(), networkRemovingKey(ID))
+		if derr != nil {
+			plog.Errorf("failed to unmark removal of network %s: %v", ID, derr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// removeNetwork removes the pools of a network marked for removal and deletes it
+// along with its ipam.
+func (config *Config) removeNetwork(nm *etcdNetworkManager, force bool) error {
+	pools, err := nm.Pools(nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get pools")
+	}
+
+	if !force {
+		bindings, err := nm.Bindings(nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to get bindings")
+		}
+
+		for idx := range bindings {
+			if bindings[idx].BindTime > bindings[idx].ReleaseTime {
+				return errorf(ErrFailedPrecondition, "network %s has bound addresses in pool %s", nm.ID, bindings[idx].PoolID.ID)
+			}
+		}
+	}
+
+	for idx := range pools {
+		err = nm.RemovePool(pools[idx].ID.ID, force)
+		if err != nil {
+			return errors.Wrapf(err, "failed to remove pool %s", pools[idx].ID.ID)
+		}
+	}
+
+	ops := []storage.Op{
+		storage.OpDelete(networkMetaKey(nm.ID)),
+		storage.OpDelete(path.Join(PostalEtcdKeyPrefix, "network", nm.ID)+"/", storage.WithPrefix()),
+		storage.OpDelete(path.Join(bindingLeasesKey(), nm.ID)+"/", storage.WithPrefix()),
+	}
+	// the ipam goes in the same transaction, it could not be found again afterwards
+	if nm.ipam != nil {
+		ops = append(ops, storage.OpDelete(ipamKeyPrefix(nm.ipam.GetID()), storage.WithPrefix()))
+	}
+
+	_, err = config.store.Txn(context.TODO()).Then(ops...).Commit()
+	if err != nil {
+		return errors.Wrap(err, "etcd transaction error")
+	}
+
+	return nil
+}
+
+// deleteIPAM removes all keys of the ipam with the given ID.
+func (config *Config) deleteIPAM(ID string) {
+	_, err := config.store.Delete(context.TODO(), ipamKeyPrefix(ID), storage.WithPrefix())
+	if err != nil {
+		plog.Errorf("failed to clean up ipam %s: %v", ID, err)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"fmt"
+
+	"github.com/jive/postal/ipam"
+	"github.com/pkg/errors"
+)
+
+// ErrorKind tells apart the reasons an operation failed, so callers can decide
+// whether to retry it.
+type ErrorKind int
+
+const (
+	// ErrUnknown is the kind of all other errors, such as storage failures.
+	ErrUnknown ErrorKind = iota
+	// ErrNotFound means a network, pool or binding does not exist.
+	ErrNotFound
+	// ErrAlreadyExists means an address is already allocated or bound.
+	ErrAlreadyExists
+	// ErrExhausted means a pool or network has no addresses left.
+	ErrExhausted
+	// ErrConflict means concurrent updates kept the operation from committing,
+	// it may succeed when retried.
+	ErrConflict
+	// ErrInvalidArgument means the request can never succeed as is.
+	ErrInvalidArgument
+	// ErrFailedPrecondition means the resource is not in a state the operation
+	// applies to, such as releasing a released binding.
+	ErrFailedPrecondition
+)
+
+var ipamErrorKinds = map[ipam.ErrorKind]ErrorKind{
+	ipam.ErrNotFound:        ErrNotFound,
+	ipam.ErrAlreadyExists:   ErrAlreadyExists,
+	ipam.ErrExhausted:       ErrExhausted,
+	ipam.ErrConflict:        ErrConflict,
+	ipam.ErrInvalidArgument: ErrInvalidArgument,
+}
+
+// Error is a failure of a known kind. Wrapped errors are told apart by their
+// cause, see ErrorKindOf.
+type Error struct {
+	Kind ErrorKind
+	msg  string
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func errorf(kind ErrorKind, format string, args ...interface{}) error {
+	return &Error{Kind: kind, msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrorKindOf returns the kind of the cause of err, which may be an error of
+// this or the ipam package.
+func ErrorKindOf(err error) ErrorKind {
+	switch cause := errors.Cause(err).(type) {
+	case *Error:
+		return cause.Kind
+	case *ipam.Error:
+		return ipamErrorKinds[cause.Kind]
+	}
+	return ErrUnknown
+}
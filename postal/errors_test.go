@@ -0,0 +1,55 @@
+package postal
+
+import (
+	"net"
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorKinds(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+	_, err := config.Network("missing")
+	assert.Equal(ErrNotFound, ErrorKindOf(err))
+
+	_, err = config.NewNetwork(nil, "10.0.0.0/33")
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(err))
+
+	nm, err := config.NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	_, err = nm.Pool("missing")
+	assert.Equal(ErrNotFound, ErrorKindOf(err))
+
+	pool, err := nm.NewPool(nil, 1, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	_, err = pool.Allocate(net.ParseIP("10.0.1.1"))
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(err))
+
+	binding, err := pool.Allocate(net.ParseIP("10.0.0.1"))
+	assert.NoError(err)
+
+	_, err = pool.Allocate(net.ParseIP("10.0.0.2"))
+	assert.Equal(ErrExhausted, ErrorKindOf(err))
+
+	assert.NoError(pool.Release(binding, false))
+	err = pool.Release(binding, false)
+	assert.Equal(ErrFailedPrecondition, ErrorKindOf(err))
+
+	_, _, err = config.NetworksPage(nil, Page{Token: "not-a-token"})
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(err))
+
+	_, err = ParseSelector("a in b")
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(err))
+
+	assert.Equal(ErrUnknown, ErrorKindOf(errors.New("etcd is down")))
+	assert.Equal(ErrUnknown, ErrorKindOf(nil))
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"encoding/json"
+	"net"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+)
+
+// PostalHistoryKeyPrefix defines the prefix of the address histories. They are
+// kept apart from the registry so they outlive released bindings and removed
+// networks.
+const PostalHistoryKeyPrefix = "/postal/history/v1/"
+
+// AddressHistoryPage returns a page of the bindings the address of the network
+// was bound by, in the order they were bound, and the token of the next page.
+// Each binding is recorded as it was when it was released, or as it was bound
+// while it still is.
+func (config *Config) AddressHistoryPage(networkID string, addr net.IP, page Page) ([]*api.Binding, string, error) {
+	if len(networkID) == 0 || addr == nil {
+		return nil, "", errorf(ErrInvalidArgument, "network ID and a valid address are required")
+	}
+
+	prefix := historyAddrKey(networkID, addr) + "/"
+	after, err := decodeToken(page.Token, prefix)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bindings := []*api.Binding{}
+	last, err := rangeKeys(config.store, prefix, after, page.Limit, func(kv *storage.KeyValue) (bool, error) {
+		binding := &api.Binding{}
+		err := json.Unmarshal(kv.Value, binding)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to unmarshal binding")
+		}
+
+		bindings = append(bindings, binding)
+		return true, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return bindings, encodeToken(last), nil
+}
@@ -0,0 +1,88 @@
+package postal
+
+import (
+	"net"
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressHistory(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+	network, err := config.NewNetwork(map[string]string{}, "10.0.0.0/24")
+	assert.NoError(err)
+	networkID := network.APINetwork().ID
+	pool, err := network.NewPool(map[string]string{}, 10, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	addr := net.ParseIP("10.0.0.5")
+
+	first, err := pool.Bind(map[string]string{"tenant": "a"}, addr, NoTTL)
+	assert.NoError(err)
+	assert.NoError(pool.Release(first, false))
+
+	// rebinding the released binding starts a new tenancy under the same ID
+	second, err := pool.Bind(map[string]string{"tenant": "b"}, addr, NoTTL)
+	assert.NoError(err)
+	assert.Equal(first.ID, second.ID)
+	assert.NoError(pool.Release(second, true))
+
+	third, err := pool.Bind(map[string]string{"tenant": "c"}, addr, NoTTL)
+	assert.NoError(err)
+
+	// other addresses have their own history
+	_, err = pool.BindAny(map[string]string{}, NoTTL)
+	assert.NoError(err)
+
+	history, token, err := config.AddressHistoryPage(networkID, addr, Page{})
+	assert.NoError(err)
+	assert.Empty(token)
+	if !assert.Equal(3, len(history)) {
+		return
+	}
+
+	for idx, tenant := range []string{"a", "b", "c"} {
+		assert.Equal(tenant, history[idx].Annotations["tenant"])
+		assert.Equal("10.0.0.5", history[idx].Address)
+		assert.Equal(pool.ID(), history[idx].PoolID.ID)
+		assert.True(history[idx].BindTime > 0)
+	}
+	assert.Equal(first.ID, history[0].ID)
+	assert.Equal(first.ID, history[1].ID)
+	assert.Equal(third.ID, history[2].ID)
+
+	assert.True(history[0].ReleaseTime > history[0].BindTime)
+	assert.True(history[1].BindTime > history[0].ReleaseTime)
+	assert.True(history[1].ReleaseTime > history[1].BindTime)
+	assert.Equal(int64(0), history[2].ReleaseTime)
+
+	// releasing the remaining tenancy completes it
+	assert.NoError(pool.Release(third, false))
+	history, token, err = config.AddressHistoryPage(networkID, addr, Page{Limit: 2})
+	assert.NoError(err)
+	assert.Equal(2, len(history))
+	history, token, err = config.AddressHistoryPage(networkID, addr, Page{Limit: 2, Token: token})
+	assert.NoError(err)
+	if assert.Equal(1, len(history)) {
+		assert.True(history[0].ReleaseTime > history[0].BindTime)
+	}
+
+	// hard releasing a released binding does not change its history
+	released, err := pool.Binding(third.ID)
+	assert.NoError(err)
+	assert.NoError(pool.Release(released, true))
+	history, _, err = config.AddressHistoryPage(networkID, addr, Page{})
+	assert.NoError(err)
+	if assert.Equal(3, len(history)) {
+		assert.Equal(released.ReleaseTime, history[2].ReleaseTime)
+	}
+
+	_, _, err = config.AddressHistoryPage("", addr, Page{})
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(err))
+}
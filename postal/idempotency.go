@@ -0,0 +1,193 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// PostalIdempotencyKeyPrefix defines the prefix of the idempotency records, which
+// are kept apart from the registry so watches of the registry do not see them.
+const PostalIdempotencyKeyPrefix = "/postal/idempotency/v1/"
+
+// PostalIdempotencyReservationTTL is how many seconds a key stays claimed by a
+// request which has not completed, so the key of a request whose server went away
+// is freed long before its window ends.
+var PostalIdempotencyReservationTTL int64 = 30
+
+func idempotencyKey(scope, key string) string {
+	return PostalIdempotencyKeyPrefix + url.QueryEscape(scope) + "/" + url.QueryEscape(key)
+}
+
+// IdempotencyRecord is what is stored under the idempotency key of a request.
+type IdempotencyRecord struct {
+	// RequestHash tells apart different requests reusing a key.
+	RequestHash string `json:"requestHash"`
+	// Response is the marshalled response, it is unset while the request runs.
+	Response []byte `json:"response,omitempty"`
+
+	key    string
+	lease  storage.LeaseID
+	ttl    int64
+	window int64
+	// revision is the one the record was last written at, later writes fail if
+	// the key was written by another request in the meantime
+	revision int64
+}
+
+// ReserveIdempotency claims key within scope for the request with requestHash.
+// The claim lasts PostalIdempotencyReservationTTL seconds, or window if shorter,
+// the response is kept for window seconds once the request completes. If the key
+// is already claimed the stored record is returned with reserved false, the
+// request must not run then.
+func (config *Config) ReserveIdempotency(scope, key, requestHash string, window int64) (record *IdempotencyRecord, reserved bool, err error) {
+	record = &IdempotencyRecord{RequestHash: requestHash, key: idempotencyKey(scope, key), window: window}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to marshal idempotency record")
+	}
+
+	record.ttl = PostalIdempotencyReservationTTL
+	if window < record.ttl {
+		record.ttl = window
+	}
+
+	// the stored record may expire between the failed reservation and reading it
+	for retry := 0; retry < 3; retry++ {
+		record.lease, err = config.store.Grant(context.TODO(), record.ttl)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "creating lease failed")
+		}
+
+		resp, err := config.store.Txn(context.TODO()).If(
+			storage.Compare(storage.Version(record.key), "=", 0),
+		).Then(
+			storage.OpPut(record.key, string(data), storage.WithLease(record.lease)),
+		).Commit()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "etcd transaction error")
+		}
+		if resp.Succeeded {
+			record.revision = resp.Revision
+			return record, true, nil
+		}
+		config.revokeLease(record.lease)
+
+		getResp, err := config.store.Get(context.TODO(), record.key)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "etcd kv get failed")
+		}
+		if len(getResp.Kvs) == 0 {
+			continue
+		}
+
+		stored := &IdempotencyRecord{}
+		if err := json.Unmarshal(getResp.Kvs[0].Value, stored); err != nil {
+			return nil, false, errors.Wrap(err, "failed to unmarshal idempotency record")
+		}
+		return stored, false, nil
+	}
+
+	return nil, false, errorf(ErrConflict, "failed to reserve idempotency key %s", key)
+}
+
+// KeepIdempotency renews the reservation of record until ctx is done, so it does
+// not lapse while a slow request runs.
+func (config *Config) KeepIdempotency(ctx context.Context, record *IdempotencyRecord) {
+	ticker := time.NewTicker(time.Duration(record.ttl) * time.Second / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := config.store.KeepAliveOnce(ctx, record.lease); err != nil && ctx.Err() == nil {
+				plog.Warningf("failed to renew reservation of idempotency key %s: %v", record.key, err)
+			}
+		}
+	}
+}
+
+// CompleteIdempotency stores the response of the request which reserved record,
+// it is kept for the window of the reservation. It fails with ErrConflict if the
+// reservation lapsed and the key was reserved again.
+func (config *Config) CompleteIdempotency(record *IdempotencyRecord, response []byte) error {
+	record.Response = response
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal idempotency record")
+	}
+
+	lease, err := config.store.Grant(context.TODO(), record.window)
+	if err != nil {
+		return errors.Wrap(err, "creating lease failed")
+	}
+
+	resp, err := config.store.Txn(context.TODO()).If(
+		storage.Compare(storage.ModRevision(record.key), "=", record.revision),
+	).Then(
+		storage.OpPut(record.key, string(data), storage.WithLease(lease)),
+	).Commit()
+	if err != nil {
+		config.revokeLease(lease)
+		return errors.Wrap(err, "etcd transaction error")
+	}
+	if !resp.Succeeded {
+		config.revokeLease(lease)
+		return errorf(ErrConflict, "idempotency key %s is no longer reserved by the request", record.key)
+	}
+
+	// the record moved to the new lease, the reservation lease holds nothing now
+	config.revokeLease(record.lease)
+	record.lease, record.revision = lease, resp.Revision
+	return nil
+}
+
+// CancelIdempotency drops the reservation of record, so the request may be
+// retried with the same key. It fails with ErrConflict if the reservation lapsed
+// and the key was reserved again.
+func (config *Config) CancelIdempotency(record *IdempotencyRecord) error {
+	resp, err := config.store.Txn(context.TODO()).If(
+		storage.Compare(storage.ModRevision(record.key), "=", record.revision),
+	).Then(
+		storage.OpDelete(record.key),
+	).Commit()
+	if err != nil {
+		return errors.Wrap(err, "etcd transaction error")
+	}
+	if !resp.Succeeded {
+		return errorf(ErrConflict, "idempotency key %s is no longer reserved by the request", record.key)
+	}
+
+	config.revokeLease(record.lease)
+	return nil
+}
+
+// revokeLease drops a lease which holds no record, it would expire by itself
+// so failures are only logged.
+func (config *Config) revokeLease(lease storage.LeaseID) {
+	if err := config.store.Revoke(context.TODO(), lease); err != nil {
+		plog.Warningf("failed to revoke idempotency lease %d: %v", lease, err)
+	}
+}
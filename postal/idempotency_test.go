@@ -0,0 +1,147 @@
+package postal
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jive/postal/storage"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestIdempotency(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+
+	record, reserved, err := config.ReserveIdempotency("BindAddress/alice", "key1", "hash1", 2)
+	assert.NoError(err)
+	assert.True(reserved)
+
+	// the key stays claimed while the request runs
+	pending, reserved, err := config.ReserveIdempotency("BindAddress/alice", "key1", "hash1", 2)
+	assert.NoError(err)
+	assert.False(reserved)
+	assert.Equal("hash1", pending.RequestHash)
+	assert.Empty(pending.Response)
+
+	assert.NoError(config.CompleteIdempotency(record, []byte("response")))
+	done, reserved, err := config.ReserveIdempotency("BindAddress/alice", "key1", "hash2", 2)
+	assert.NoError(err)
+	assert.False(reserved)
+	assert.Equal("hash1", done.RequestHash)
+	assert.Equal([]byte("response"), done.Response)
+
+	// keys are scoped
+	other, reserved, err := config.ReserveIdempotency("BindAddress/bob", "key1", "hash1", 2)
+	assert.NoError(err)
+	assert.True(reserved)
+
+	// a cancelled key may be reserved again
+	assert.NoError(config.CancelIdempotency(other))
+	_, reserved, err = config.ReserveIdempotency("BindAddress/bob", "key1", "hash1", 2)
+	assert.NoError(err)
+	assert.True(reserved)
+
+	// records expire with the window
+	time.Sleep(4 * time.Second)
+	_, reserved, err = config.ReserveIdempotency("BindAddress/alice", "key1", "hash2", 2)
+	assert.NoError(err)
+	assert.True(reserved)
+}
+
+// revokeCountingStore counts the leases revoked through it.
+type revokeCountingStore struct {
+	storage.Store
+	revoked int32
+}
+
+func (s *revokeCountingStore) Revoke(ctx context.Context, id storage.LeaseID) error {
+	atomic.AddInt32(&s.revoked, 1)
+	return s.Store.Revoke(ctx, id)
+}
+
+func TestIdempotencyReservationLease(t *testing.T) {
+	assert := assert.New(t)
+	backend, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	defer func(ttl int64) { PostalIdempotencyReservationTTL = ttl }(PostalIdempotencyReservationTTL)
+	PostalIdempotencyReservationTTL = 1
+
+	store := &revokeCountingStore{Store: backend}
+	config := (&Config{}).WithStore(store)
+
+	// the lease of a lost reservation is revoked
+	_, reserved, err := config.ReserveIdempotency("BindAddress/alice", "abandoned", "hash1", 60)
+	assert.NoError(err)
+	assert.True(reserved)
+	_, reserved, err = config.ReserveIdempotency("BindAddress/alice", "abandoned", "hash1", 60)
+	assert.NoError(err)
+	assert.False(reserved)
+	assert.Equal(int32(1), atomic.LoadInt32(&store.revoked))
+
+	record, reserved, err := config.ReserveIdempotency("BindAddress/alice", "completed", "hash1", 60)
+	assert.NoError(err)
+	assert.True(reserved)
+	assert.NoError(config.CompleteIdempotency(record, []byte("response")))
+	assert.Equal(int32(2), atomic.LoadInt32(&store.revoked))
+
+	// a request which never completes frees its key well before the window,
+	// a completed one is kept for the window
+	time.Sleep(2500 * time.Millisecond)
+	_, reserved, err = config.ReserveIdempotency("BindAddress/alice", "abandoned", "hash1", 60)
+	assert.NoError(err)
+	assert.True(reserved)
+
+	done, reserved, err := config.ReserveIdempotency("BindAddress/alice", "completed", "hash1", 60)
+	assert.NoError(err)
+	assert.False(reserved)
+	assert.Equal([]byte("response"), done.Response)
+}
+
+func TestIdempotencyLapsedReservation(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	defer func(ttl int64) { PostalIdempotencyReservationTTL = ttl }(PostalIdempotencyReservationTTL)
+	PostalIdempotencyReservationTTL = 1
+
+	config := (&Config{}).WithStore(store)
+
+	// a kept reservation outlives its ttl
+	kept, reserved, err := config.ReserveIdempotency("BindAddress/alice", "kept", "hash1", 60)
+	assert.NoError(err)
+	assert.True(reserved)
+	ctx, cancel := context.WithCancel(context.Background())
+	go config.KeepIdempotency(ctx, kept)
+	time.Sleep(2500 * time.Millisecond)
+	cancel()
+	_, reserved, err = config.ReserveIdempotency("BindAddress/alice", "kept", "hash1", 60)
+	assert.NoError(err)
+	assert.False(reserved)
+	assert.NoError(config.CompleteIdempotency(kept, []byte("kept")))
+
+	// a lapsed reservation no longer owns the key once it is reserved again
+	lapsed, reserved, err := config.ReserveIdempotency("BindAddress/alice", "lapsed", "hash1", 60)
+	assert.NoError(err)
+	assert.True(reserved)
+	time.Sleep(2500 * time.Millisecond)
+	retry, reserved, err := config.ReserveIdempotency("BindAddress/alice", "lapsed", "hash1", 60)
+	assert.NoError(err)
+	assert.True(reserved)
+
+	assert.Equal(ErrConflict, ErrorKindOf(config.CompleteIdempotency(lapsed, []byte("first"))))
+	assert.Equal(ErrConflict, ErrorKindOf(config.CancelIdempotency(lapsed)))
+	assert.NoError(config.CompleteIdempotency(retry, []byte("retry")))
+
+	done, reserved, err := config.ReserveIdempotency("BindAddress/alice", "lapsed", "hash1", 60)
+	assert.NoError(err)
+	assert.False(reserved)
+	assert.Equal([]byte("retry"), done.Response)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// ExpireBindings releases leased bindings which were not renewed in time.
+// Bindings whose lease expired while nobody was watching are released first, then
+// the store is watched for lease expiries until ctx is done.
+func (config *Config) ExpireBindings(ctx context.Context) error {
+	rev, err := config.expireStaleBindings(ctx)
+	if err != nil {
+		return errors.Wrap(err, "releasing expired bindings failed")
+	}
+
+	wch := config.store.Watch(ctx, bindingLeasesKey()+"/", storage.WithPrefix(), storage.WithRev(rev+1))
+	for resp := range wch {
+		if err := resp.Err(); err != nil {
+			return errors.Wrap(err, "lease watch failed")
+		}
+
+		for _, ev := range resp.Events {
+			if ev.Type != storage.EventTypeDelete {
+				continue
+			}
+
+			parts := strings.Split(strings.TrimPrefix(string(ev.Kv.Key), PostalEtcdKeyPrefix), "/")
+			if len(parts) != 4 {
+				continue
+			}
+
+			err := config.expireBinding(parts[1], parts[2], parts[3])
+			if err != nil {
+				plog.Errorf("failed to expire binding %s in pool %s: %v", parts[3], parts[2], err)
+			}
+		}
+	}
+
+	return ctx.Err()
+}
+
+// expireStaleBindings releases every bound, leased binding that has no lease key
+// left. It returns a revision from before the bindings were read.
+func (config *Config) expireStaleBindings(ctx context.Context) (int64, error) {
+	resp, err := config.store.Get(ctx, bindingLeasesKey()+"/", storage.WithPrefix(), storage.WithCountOnly())
+	if err != nil {
+		return 0, errors.Wrap(err, "etcd kv range failed")
+	}
+
+	_, err = rangeKeys(config.store, path.Join(PostalEtcdKeyPrefix, "network")+"/", "", 0, func(kv *storage.KeyValue) (bool, error) {
+		parts := strings.Split(strings.TrimPrefix(string(kv.Key), PostalEtcdKeyPrefix), "/")
+		if !isBindingKey(parts) {
+			return false, nil
+		}
+
+		binding := &api.Binding{}
+		if json.Unmarshal(kv.Value, binding) != nil {
+			return false, nil
+		}
+
+		if !(&etcdBinding{binding, kv.Version}).isBound() || binding.Ttl <= NoTTL {
+			return false, nil
+		}
+
+		err := config.expireBinding(parts[1], parts[3], parts[5])
+		if err != nil {
+			plog.Errorf("failed to expire binding %s in pool %s: %v", parts[5], parts[3], err)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Revision, nil
+}
+
+// expireBinding releases the binding if it is still bound with a lease and the lease
+// key is gone. Bindings which were released, rebound or removed in the meantime are
+// left alone.
+func (config *Config) expireBinding(networkID, poolID, bindingID string) error {
+	resp, err := config.store.Get(context.TODO(), bindingIDKey(networkID, poolID, bindingID))
+	if err != nil {
+		return errors.Wrap(err, "etcd kv get failed")
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil
+	}
+
+	binding := &api.Binding{}
+	err = json.Unmarshal(resp.Kvs[0].Value, binding)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal binding")
+	}
+
+	if !(&etcdBinding{binding, resp.Kvs[0].Version}).isBound() || binding.Ttl <= NoTTL {
+		return nil
+	}
+
+	resp, err = config.store.Get(context.TODO(), bindingLeaseKey(networkID, poolID, bindingID))
+	if err != nil {
+		return errors.Wrap(err, "etcd kv get failed")
+	}
+
+	if len(resp.Kvs) != 0 {
+		return nil
+	}
+
+	nm, err := config.Network(networkID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get network")
+	}
+
+	pm, err := nm.Pool(poolID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get pool")
+	}
+
+	plog.Infof("binding %s (%s) lease expired, releasing", binding.ID, binding.Address)
+	return pm.Release(binding, false)
+}
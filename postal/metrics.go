@@ -0,0 +1,40 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	bindingTxnRetries = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "postal",
+		Subsystem: "binding",
+		Name:      "txn_retries_total",
+		Help:      "Total number of times a binding transaction was retried.",
+	})
+
+	bindingCASFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "postal",
+		Subsystem: "binding",
+		Name:      "cas_failures_total",
+		Help:      "Total number of binding transactions that failed their compare.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(bindingTxnRetries)
+	prometheus.MustRegister(bindingCASFailures)
+}
@@ -19,32 +19,64 @@ package postal
 import (
 	"encoding/json"
 	"net"
-	"regexp"
+	"path"
 	"strings"
 
 	"golang.org/x/net/context"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/jive/postal/api"
+	"github.com/jive/postal/ipam"
+	"github.com/jive/postal/storage"
 	"github.com/pkg/errors"
 )
 
 // NetworkManager defines the interface for how to interact with a Network of addresses.
 type NetworkManager interface {
-	Pools(filters map[string]string) ([]*api.Pool, error)
+	Pools(sel *Selector) ([]*api.Pool, error)
+	// PoolsPage returns a page of the pools matching the selector and the token of
+	// the next page.
+	PoolsPage(sel *Selector, page Page) ([]*api.Pool, string, error)
 	Pool(ID string) (PoolManager, error)
-	NewPool(annotations map[string]string, max uint64, poolType api.Pool_Type) (PoolManager, error)
+	NewPool(annotations map[string]string, max uint64, poolType api.Pool_Type, opts ...PoolOption) (PoolManager, error)
+	// RemovePool deletes the pool, hard releasing its bindings back to the network.
+	// Unless force is set, a pool with bound addresses is not removed.
+	RemovePool(ID string, force bool) error
 	Binding(net.IP) (*api.Binding, error)
-	Bindings(filters map[string]string) ([]*api.Binding, error)
+	Bindings(sel *Selector) ([]*api.Binding, error)
+	// BindingsPage returns a page of the bindings of all pools matching the selector
+	// and the token of the next page.
+	BindingsPage(sel *Selector, page Page) ([]*api.Binding, string, error)
+	// Usage counts the addresses allocated from the network and the bindings of
+	// each of its pools.
+	Usage() (*NetworkUsage, error)
 	APINetwork() *api.Network
 }
 
+// PoolOption configures a pool created with NewPool.
+type PoolOption func(*api.Pool)
+
+// WithQuarantine keeps released bindings of the pool from being bound again for
+// the given number of seconds.
+func WithQuarantine(seconds int64) PoolOption {
+	return func(p *api.Pool) {
+		p.Quarantine = seconds
+	}
+}
+
+// WithStrategy sets the strategy the pool's BindAny reuses released bindings by.
+func WithStrategy(strategy api.Pool_Strategy) PoolOption {
+	return func(p *api.Pool) {
+		p.Strategy = strategy
+	}
+}
+
 type etcdNetworkManager struct {
 	ID          string
 	cidr        string
 	annotations map[string]string
 
-	etcd *clientv3.Client
+	store storage.Store
+	ipam  ipam.IPAM
 }
 
 func (nm *etcdNetworkManager) APINetwork() *api.Network {
@@ -55,67 +87,56 @@ func (nm *etcdNetworkManager) APINetwork() *api.Network {
 	}
 }
 
-func (nm *etcdNetworkManager) Pools(filters map[string]string) ([]*api.Pool, error) {
-	resp, err := nm.etcd.KV.Get(context.Background(), networkPoolsKey(nm.ID), clientv3.WithPrefix())
+func (nm *etcdNetworkManager) Pools(sel *Selector) ([]*api.Pool, error) {
+	pools, _, err := nm.PoolsPage(sel, Page{})
+	return pools, err
+}
+
+func (nm *etcdNetworkManager) PoolsPage(sel *Selector, page Page) ([]*api.Pool, string, error) {
+	after, err := decodeToken(page.Token, networkPoolsKey(nm.ID)+"/")
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	noFilter := filters == nil || len(filters) == 0
+	pools, last, err := nm.poolsAfter(sel, after, page.Limit)
+	if err != nil {
+		return nil, "", err
+	}
+	return pools, encodeToken(last), nil
+}
 
+// poolsAfter ranges over the pools following the pool key after.
+func (nm *etcdNetworkManager) poolsAfter(sel *Selector, after string, limit int) ([]*api.Pool, string, error) {
 	pools := []*api.Pool{}
-	for idx := range resp.Kvs {
+	last, err := rangeKeys(nm.store, networkPoolsKey(nm.ID)+"/", after, limit, func(kv *storage.KeyValue) (bool, error) {
 		pool := &api.Pool{}
-		err = json.Unmarshal(resp.Kvs[idx].Value, pool)
+		err := json.Unmarshal(kv.Value, pool)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to unmarshal pool")
+			return false, errors.Wrap(err, "failed to unmarshal pool")
 		}
 
-		if noFilter {
-			pools = append(pools, pool)
-		} else {
-			var matched bool
-			for field, filter := range filters {
-				switch field {
-				case "_id":
-					matched, err = regexp.MatchString(filter, pool.ID.ID)
-				case "_network":
-					matched, err = regexp.MatchString(filter, pool.ID.NetworkID)
-				case "_type":
-					matched, err = regexp.MatchString(strings.ToLower(filter), strings.ToLower(pool.Type.String()))
-				default:
-					if val, ok := pool.Annotations[field]; ok {
-						matched, err = regexp.MatchString(filter, val)
-					} else {
-						break
-					}
-				}
-				if err != nil {
-					return nil, errors.Wrapf(err, "failed to compile filter '%s'", filter)
-				}
-
-				if !matched {
-					break
-				}
-			}
-
-			if matched {
-				pools = append(pools, pool)
-			}
+		if !sel.MatchPool(pool) {
+			return false, nil
 		}
+
+		pools = append(pools, pool)
+		return true, nil
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return pools, nil
+	return pools, last, nil
 }
 
 func (nm *etcdNetworkManager) Pool(ID string) (PoolManager, error) {
-	resp, err := nm.etcd.Get(context.TODO(), poolMetaKey(nm.ID, ID))
+	resp, err := nm.store.Get(context.TODO(), poolMetaKey(nm.ID, ID))
 	if err != nil {
 		return nil, err
 	}
 
 	if len(resp.Kvs) != 1 {
-		return nil, errors.New("pool not found")
+		return nil, errorf(ErrNotFound, "pool not found")
 	}
 
 	pool := &api.Pool{}
@@ -125,12 +146,13 @@ func (nm *etcdNetworkManager) Pool(ID string) (PoolManager, error) {
 	}
 
 	return &etcdPoolManager{
-		etcd: nm.etcd,
-		pool: pool,
+		store: nm.store,
+		ipam:  nm.ipam,
+		pool:  pool,
 	}, nil
 }
 
-func (nm *etcdNetworkManager) NewPool(annotations map[string]string, max uint64, poolType api.Pool_Type) (PoolManager, error) {
+func (nm *etcdNetworkManager) NewPool(annotations map[string]string, max uint64, poolType api.Pool_Type, opts ...PoolOption) (PoolManager, error) {
 	pool := &api.Pool{
 		Annotations:      mergeMap(nm.annotations, annotations),
 		MaximumAddresses: max,
@@ -140,28 +162,47 @@ func (nm *etcdNetworkManager) NewPool(annotations map[string]string, max uint64,
 			ID:        newPoolID(),
 		},
 	}
+	for _, opt := range opts {
+		opt(pool)
+	}
 
 	poolBytes, err := json.Marshal(pool)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = nm.etcd.KV.Put(
-		context.TODO(),
-		poolMetaKey(nm.ID, pool.ID.ID),
-		string(poolBytes),
-	)
+	// pools are not added to removed networks, or ones being removed
+	resp, err := nm.store.Txn(context.TODO()).If(
+		storage.Compare(storage.Version(networkMetaKey(nm.ID)), ">", 0),
+		storage.Compare(storage.Version(networkRemovingKey(nm.ID)), "=", 0),
+	).Then(
+		storage.OpPut(poolMetaKey(nm.ID, pool.ID.ID), string(poolBytes)),
+	).Commit()
 
 	if err != nil {
 		return nil, err
 	}
 
+	if !resp.Succeeded {
+		return nil, errorf(ErrNotFound, "network %s was removed", nm.ID)
+	}
+
 	return &etcdPoolManager{
-		etcd: nm.etcd,
-		pool: pool,
+		store: nm.store,
+		ipam:  nm.ipam,
+		pool:  pool,
 	}, nil
 }
 
+func (nm *etcdNetworkManager) RemovePool(ID string, force bool) error {
+	pm, err := nm.Pool(ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get pool")
+	}
+
+	return pm.(*etcdPoolManager).remove(force)
+}
+
 func (nm *etcdNetworkManager) Binding(addr net.IP) (*api.Binding, error) {
 	binding, err := nm.getBindingForAddr(addr)
 	if err != nil {
@@ -171,27 +212,41 @@ func (nm *etcdNetworkManager) Binding(addr net.IP) (*api.Binding, error) {
 	return binding.Binding, nil
 }
 
-func (nm *etcdNetworkManager) Bindings(filters map[string]string) ([]*api.Binding, error) {
-	pools, err := nm.Pools(nil)
+func (nm *etcdNetworkManager) Bindings(sel *Selector) ([]*api.Binding, error) {
+	bindings, _, err := nm.BindingsPage(sel, Page{})
+	return bindings, err
+}
+
+func (nm *etcdNetworkManager) BindingsPage(sel *Selector, page Page) ([]*api.Binding, string, error) {
+	// the bindings of all pools live below a single prefix
+	prefix := path.Join(PostalEtcdKeyPrefix, "network", nm.ID, "pool") + "/"
+	after, err := decodeToken(page.Token, prefix)
 	if err != nil {
-		return nil, errors.Wrapf(err, "get pools failed")
+		return nil, "", err
 	}
 
 	bindings := []*api.Binding{}
-	for idx := range pools {
-		pm := &etcdPoolManager{
-			etcd: nm.etcd,
-			pool: pools[idx],
+	last, err := rangeKeys(nm.store, prefix, after, page.Limit, func(kv *storage.KeyValue) (bool, error) {
+		if !isBindingKey(strings.Split(strings.TrimPrefix(string(kv.Key), PostalEtcdKeyPrefix), "/")) {
+			return false, nil
 		}
-		etcdBindings, err := pm.listBindings(filters)
+
+		binding := &api.Binding{}
+		err := json.Unmarshal(kv.Value, binding)
 		if err != nil {
-			return nil, errors.Wrapf(err, "get bindings for pool %s failed", pools[idx].ID.ID)
+			return false, errors.Wrap(err, "failed to unmarshal binding")
 		}
-		for _, binding := range etcdBindings {
-			bindings = append(bindings, binding.Binding)
+
+		if !sel.MatchBinding(binding) {
+			return false, nil
 		}
 
+		bindings = append(bindings, binding)
+		return true, nil
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return bindings, nil
+	return bindings, encodeToken(last), nil
 }
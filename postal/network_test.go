@@ -1,27 +1,25 @@
 package postal
 
 import (
+	"net"
+	"strings"
 	"testing"
-	"time"
 
-	"github.com/coreos/etcd/clientv3"
+	"golang.org/x/net/context"
+
 	"github.com/jive/postal/api"
+	"github.com/jive/postal/ipam"
+	"github.com/jive/postal/storage"
+	"github.com/jive/postal/storage/storagetest"
 	"github.com/stretchr/testify/assert"
-	"golang.org/x/net/context"
 )
 
 func TestPoolsFilter(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	config := (&Config{}).WithEtcdClient(cli)
+	config := (&Config{}).WithStore(store)
 	network, err := config.NewNetwork(map[string]string{
 		"example.com/networkName": "net1",
 		"example.com/cluster":     "us-east-1",
@@ -47,28 +45,217 @@ func TestPoolsFilter(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal(3, len(pools))
 
-	pools, err = network.Pools(map[string]string{"_id": pool1.ID()})
+	pools, err = network.Pools(filterSelector(t, map[string]string{"_id": pool1.ID()}))
 	assert.NoError(err)
 	assert.Equal(1, len(pools))
 
-	pools, err = network.Pools(map[string]string{"_network": network.APINetwork().ID})
+	pools, err = network.Pools(filterSelector(t, map[string]string{"_network": network.APINetwork().ID}))
 	assert.NoError(err)
 	assert.Equal(3, len(pools))
 
-	pools, err = network.Pools(map[string]string{"_type": "fixed"})
+	pools, err = network.Pools(filterSelector(t, map[string]string{"_type": "fixed"}))
 	assert.NoError(err)
 	assert.Equal(2, len(pools))
 
-	pools, err = network.Pools(map[string]string{"example.com/poolName": "pool*"})
+	pools, err = network.Pools(filterSelector(t, map[string]string{"example.com/poolName": "pool*"}))
 	assert.NoError(err)
 	assert.Equal(2, len(pools))
 
-	pools, err = network.Pools(map[string]string{"example.com/foo": ".*"})
+	pools, err = network.Pools(filterSelector(t, map[string]string{"example.com/foo": ".*"}))
 	assert.NoError(err)
 	assert.Equal(0, len(pools))
 
-	pools, err = network.Pools(map[string]string{"example.com/poolName": "a(b"})
+	_, err = NewSelector("", map[string]string{"example.com/poolName": "a(b"})
 	assert.Error(err)
-	assert.Equal(0, len(pools))
 
 }
+
+func TestBindingsPage(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	// make ranges span several store reads
+	defer func(size int64) { rangeBatchSize = size }(rangeBatchSize)
+	rangeBatchSize = 2
+
+	config := (&Config{}).WithStore(store)
+	network, err := config.NewNetwork(map[string]string{}, "10.0.0.0/24")
+	assert.NoError(err)
+
+	for _, name := range []string{"a", "b"} {
+		pool, err := network.NewPool(map[string]string{"name": name}, 10, api.Pool_DYNAMIC)
+		assert.NoError(err)
+		for i := 0; i < 4; i++ {
+			_, err = pool.BindAny(nil, NoTTL)
+			assert.NoError(err)
+		}
+		size, err := pool.CurrentSize()
+		assert.NoError(err)
+		assert.Equal(uint64(4), size)
+	}
+
+	seen := map[string]bool{}
+	page := Page{Limit: 3}
+	for _, size := range []int{3, 3, 2} {
+		bindings, token, err := network.BindingsPage(nil, page)
+		assert.NoError(err)
+		assert.Len(bindings, size)
+		for _, binding := range bindings {
+			assert.False(seen[binding.ID])
+			seen[binding.ID] = true
+		}
+		page.Token = token
+	}
+	assert.Empty(page.Token)
+	assert.Len(seen, 8)
+
+	bindings, token, err := network.BindingsPage(filterSelector(t, map[string]string{"name": "b"}), Page{Limit: 4})
+	assert.NoError(err)
+	assert.Len(bindings, 4)
+	for _, binding := range bindings {
+		assert.Equal("b", binding.Annotations["name"])
+	}
+
+	bindings, _, err = network.BindingsPage(filterSelector(t, map[string]string{"name": "b"}), Page{Limit: 4, Token: token})
+	assert.NoError(err)
+	assert.Len(bindings, 0)
+
+	_, _, err = network.BindingsPage(nil, Page{Token: "not-a-token"})
+	assert.Error(err)
+
+	// a pool token does not continue a binding range
+	_, poolToken, err := network.PoolsPage(nil, Page{Limit: 1})
+	assert.NoError(err)
+	_, _, err = network.BindingsPage(nil, Page{Token: poolToken})
+	assert.Error(err)
+}
+
+func TestPoolsPageAcrossNetworks(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+	for _, cidr := range []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"} {
+		network, err := config.NewNetwork(map[string]string{}, cidr)
+		assert.NoError(err)
+		for i := 0; i < 2; i++ {
+			_, err = network.NewPool(nil, 5, api.Pool_DYNAMIC)
+			assert.NoError(err)
+		}
+	}
+
+	seen := map[string]bool{}
+	page := Page{Limit: 4}
+	for _, size := range []int{4, 2} {
+		pools, token, err := config.PoolsPage(nil, page)
+		assert.NoError(err)
+		assert.Len(pools, size)
+		for _, pool := range pools {
+			seen[pool.ID.ID] = true
+		}
+		page.Token = token
+	}
+	assert.Empty(page.Token)
+	assert.Len(seen, 6)
+}
+
+// hookStore runs hook once, right after the first read of a key ending in suffix.
+type hookStore struct {
+	storage.Store
+	suffix string
+	hook   func()
+}
+
+func (s *hookStore) Get(ctx context.Context, key string, opts ...storage.OpOption) (*storage.GetResponse, error) {
+	resp, err := s.Store.Get(ctx, key, opts...)
+	if hook := s.hook; hook != nil && strings.HasSuffix(key, s.suffix) {
+		s.hook = nil
+		hook()
+	}
+	return resp, err
+}
+
+func TestRemovePoolConcurrentBind(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	hooked := &hookStore{Store: store, suffix: "/bindings/"}
+	config := (&Config{}).WithStore(hooked)
+	network, err := config.NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+	pool, err := network.NewPool(nil, 5, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	// an address is bound right after the removal listed the bindings
+	var bound *api.Binding
+	hooked.hook = func() {
+		bound, err = pool.BindAny(nil, NoTTL)
+		assert.NoError(err)
+	}
+	assert.Equal(ErrConflict, ErrorKindOf(network.RemovePool(pool.ID(), false)))
+
+	binding, err := network.Binding(net.ParseIP(bound.Address))
+	assert.NoError(err)
+	assert.Equal(bound.ID, binding.ID)
+
+	assert.Equal(ErrFailedPrecondition, ErrorKindOf(network.RemovePool(pool.ID(), false)))
+	assert.NoError(network.RemovePool(pool.ID(), true))
+
+	// the removed pool does not grow again
+	_, err = pool.BindAny(nil, NoTTL)
+	assert.Error(err)
+	_, err = network.Binding(net.ParseIP(bound.Address))
+	assert.Equal(ErrNotFound, ErrorKindOf(err))
+}
+
+func TestRemoveNetworkRefusesPools(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	hooked := &hookStore{Store: store, suffix: "/pools/"}
+	config := (&Config{}).WithStore(hooked)
+	network, err := config.NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	// a pool is added right after the removal listed the pools
+	hooked.hook = func() {
+		_, err := network.NewPool(nil, 5, api.Pool_DYNAMIC)
+		assert.Equal(ErrNotFound, ErrorKindOf(err))
+	}
+	assert.NoError(config.RemoveNetwork(network.APINetwork().ID, false))
+
+	_, err = network.NewPool(nil, 5, api.Pool_DYNAMIC)
+	assert.Equal(ErrNotFound, ErrorKindOf(err))
+
+	resp, err := store.Get(context.TODO(), PostalEtcdKeyPrefix, storage.WithPrefix(), storage.WithCountOnly())
+	assert.NoError(err)
+	assert.Equal(int64(0), resp.Count)
+
+	// the ipam is removed along with the network
+	resp, err = store.Get(context.TODO(), ipam.IpamEtcdKeyPrefix, storage.WithPrefix(), storage.WithCountOnly())
+	assert.NoError(err)
+	assert.Equal(int64(0), resp.Count)
+}
+
+func TestRemoveNetworkFailureAllowsPools(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+	network, err := config.NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+	pool, err := network.NewPool(nil, 5, api.Pool_DYNAMIC)
+	assert.NoError(err)
+	_, err = pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+
+	assert.Equal(ErrFailedPrecondition, ErrorKindOf(config.RemoveNetwork(network.APINetwork().ID, false)))
+
+	_, err = network.NewPool(nil, 5, api.Pool_DYNAMIC)
+	assert.NoError(err)
+}
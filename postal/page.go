@@ -0,0 +1,104 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// rangeBatchSize is the number of keys read from the store at once while ranging.
+var rangeBatchSize int64 = 500
+
+// Page selects part of a range. Ranges return the token of the next page along
+// with their results, an empty token means the range is exhausted. A full page
+// may be followed by an empty one.
+type Page struct {
+	// Limit is the maximum number of results, 0 returns all of them
+	Limit int
+	// Token continues the range after the previous page
+	Token string
+}
+
+// rangeKeys calls take with the keys under prefix which follow the key after, in
+// key order, reading them from the store in batches. take reports whether it kept
+// the key. Once limit keys were kept the last of them is returned, if the range
+// ends first the returned key is empty.
+func rangeKeys(store storage.Store, prefix, after string, limit int, take func(*storage.KeyValue) (bool, error)) (string, error) {
+	return rangeKeysTo(store, prefix, after, storage.PrefixEnd(prefix), limit, take)
+}
+
+// rangeKeysTo is rangeKeys stopping before the key end.
+func rangeKeysTo(store storage.Store, prefix, after, end string, limit int, take func(*storage.KeyValue) (bool, error)) (string, error) {
+	start := prefix
+	if len(after) > 0 {
+		start = after + "\x00"
+	}
+
+	taken := 0
+	for {
+		resp, err := store.Get(context.TODO(), start, storage.WithRange(end), storage.WithLimit(rangeBatchSize))
+		if err != nil {
+			return "", errors.Wrap(err, "etcd kv range failed")
+		}
+
+		for _, kv := range resp.Kvs {
+			kept, err := take(kv)
+			if err != nil {
+				return "", err
+			}
+
+			if kept {
+				taken++
+			}
+			if limit > 0 && taken == limit {
+				return string(kv.Key), nil
+			}
+		}
+
+		if !resp.More || len(resp.Kvs) == 0 {
+			return "", nil
+		}
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// encodeToken returns the continuation token of a range which ended at key.
+func encodeToken(key string) string {
+	if len(key) == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// decodeToken returns the key a continuation token points at, which must be
+// under prefix.
+func decodeToken(token, prefix string) (string, error) {
+	if len(token) == 0 {
+		return "", nil
+	}
+
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(key), prefix) {
+		return "", errorf(ErrInvalidArgument, "invalid continuation token")
+	}
+	return string(key), nil
+}
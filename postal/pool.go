@@ -18,13 +18,15 @@ package postal
 
 import (
 	"encoding/json"
-	"fmt"
 	"net"
+	"strconv"
+	"time"
 
 	"golang.org/x/net/context"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/jive/postal/api"
+	"github.com/jive/postal/ipam"
+	"github.com/jive/postal/storage"
 	"github.com/pkg/errors"
 )
 
@@ -48,13 +50,26 @@ type PoolManager interface {
 	// the call will fail.
 	// For DYNAMIC pools, Bind will attempt to allocate the requested address if it
 	// has not been previously allocated unless the pool has hit its max address limit.
-	Bind(annotations map[string]string, requestedAddress net.IP) (*api.Binding, error)
+	//
+	// A ttl greater than NoTTL leases the binding for ttl seconds. A leased binding
+	// is released when it is not renewed before the lease expires.
+	//
+	// Released bindings are quarantined for the pool's quarantine period, during
+	// which they are not bound again unless IgnoreQuarantine is given.
+	//
+	// Binds for an owner, see WithOwner, are idempotent.
+	Bind(annotations map[string]string, requestedAddress net.IP, ttl int64, opts ...BindOption) (*api.Binding, error)
 	// BindAny is very similar to Bind, except it does not take a specific address.
-	// It will instead bind an allocated address at random.
+	// It will instead bind an allocated address chosen by the pool's strategy.
 	// Like Bind, FIXED type pools must have their addresses allocated prior to binding.
 	// If the pool does not have enough addresses for the request and is of type DYNAMIC,
 	// it will attempt to allocate an additional address for the parent network block.
-	BindAny(annotations map[string]string) (*api.Binding, error)
+	// The ttl, quarantine and owner are handled the same as in Bind.
+	BindAny(annotations map[string]string, ttl int64, opts ...BindOption) (*api.Binding, error)
+	// BindMany binds as many addresses as there are annotations, in the same way
+	// as BindAny but in a single transaction. Either all of them are bound or none
+	// is. Owners are not supported.
+	BindMany(annotations []map[string]string, ttl int64, opts ...BindOption) ([]*api.Binding, error)
 	// Release will place the address back into a state where it can be bound again within the pool.
 	// If the pool is a DYNAMIC type, it will place a TTL on the binding, such that when it expires it
 	// is released back into the parent network block.
@@ -62,17 +77,21 @@ type PoolManager interface {
 	// The hard flag, if true, indicates to do a hard release which removed the address from
 	// the pool back to the parent network block
 	Release(binding *api.Binding, hard bool) error
+	// Renew extends the lease of a binding bound with a ttl by another ttl period.
+	// It fails if the binding is not bound or its lease has already expired.
+	Renew(ID string) (*api.Binding, error)
 	// Binding returns the api.Binding for the given ID.
 	Binding(ID string) (*api.Binding, error)
 	// ID returns the pool's ID
 	ID() string
 	// CurrentSize will enumerate the existing bindings for a pool and return the cardinatlity.
-	CurrentSize() uint64
+	CurrentSize() (uint64, error)
 	// MaxSize indicates what the maximum number of addresses a pool may hold.
 	// A MaxSize of 0, disables this check and allows for a unbounded pool
 	MaxSize() uint64
 	// SetMaxSize updates the pool size limit to the given max.
-	// If the new max is greater than the current size, this sould return an error.
+	// If the new max is less than the current size, this returns an error.
+	// It fails with ErrConflict if the pool changes concurrently.
 	SetMaxSize(uint64) error
 	// Type will be one of api.Pool_FIXED or api.Pool_DYNAMIC
 	Type() api.Pool_Type
@@ -80,9 +99,54 @@ type PoolManager interface {
 	APIPool() *api.Pool
 }
 
+// BindOption configures a Bind or BindAny call.
+type BindOption func(*bindOptions)
+
+type bindOptions struct {
+	ignoreQuarantine bool
+	affinityKey      string
+	owner            string
+
+	// version of the owner's index key when it was looked up
+	ownerVersion int64
+}
+
+func newBindOptions(opts []BindOption) *bindOptions {
+	o := &bindOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// IgnoreQuarantine lets a bind reuse quarantined bindings.
+func IgnoreQuarantine() BindOption {
+	return func(o *bindOptions) {
+		o.ignoreQuarantine = true
+	}
+}
+
+// WithOwner makes the bind idempotent for owner. The binding the owner holds in
+// the pool is returned as is, and the owner's previous address is bound again if
+// it is free, even while it is quarantined.
+func WithOwner(owner string) BindOption {
+	return func(o *bindOptions) {
+		o.owner = owner
+	}
+}
+
+// WithAffinityKey records key on the binding, STICKY pools rebind the address
+// last held by the same key when it is free.
+func WithAffinityKey(key string) BindOption {
+	return func(o *bindOptions) {
+		o.affinityKey = key
+	}
+}
+
 type etcdPoolManager struct {
-	etcd *clientv3.Client
-	pool *api.Pool
+	store storage.Store
+	ipam  ipam.IPAM
+	pool  *api.Pool
 }
 
 func (pm *etcdPoolManager) APIPool() *api.Pool {
@@ -98,9 +162,6 @@ func (pm *etcdPoolManager) Type() api.Pool_Type {
 }
 
 func (pm *etcdPoolManager) Allocate(requestedAddress net.IP) (*api.Binding, error) {
-	if pm.CurrentSize() >= pm.MaxSize() {
-		return nil, errors.New("allocate failed: maximum addresses reached")
-	}
 	binding := newBinding(&api.Binding{
 		PoolID:      pm.pool.ID,
 		ID:          newBindingID(),
@@ -115,57 +176,209 @@ func (pm *etcdPoolManager) Allocate(requestedAddress net.IP) (*api.Binding, erro
 	return binding.Binding, nil
 }
 
-func (pm *etcdPoolManager) BindAny(annotations map[string]string) (*api.Binding, error) {
+func (pm *etcdPoolManager) BindAny(annotations map[string]string, ttl int64, opts ...BindOption) (*api.Binding, error) {
+	o := newBindOptions(opts)
+	annotations = mergeMap(pm.pool.Annotations, annotations)
+
+	if o.owner != "" {
+		owned, err := pm.bindOwned(annotations, nil, ttl, o)
+		if owned != nil || err != nil {
+			return owned, err
+		}
+	}
+
 	existingBindings, err := pm.listBindings(nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "list bindings failed")
 	}
-	annotations = mergeMap(pm.pool.Annotations, annotations)
 
 	filteredBindings := filterBoundBindings(existingBindings)
+	orderBindings(pm.pool.Strategy, filteredBindings, o.affinityKey)
 
-	// First, check existing unbound bindings and reuse if any exists
+	// First, check existing unbound bindings and reuse if any exists, in the
+	// order of the pool's strategy
+	now := time.Now()
+	quarantined := 0
 	for idx := range filteredBindings {
-		err = pm.rebindBinding(filteredBindings[idx], annotations)
+		if !o.ignoreQuarantine && filteredBindings[idx].isQuarantined(now) {
+			quarantined++
+			continue
+		}
+		err = pm.rebindBinding(filteredBindings[idx], annotations, ttl, o)
 		if err == nil {
 			return filteredBindings[idx].Binding, nil
 		}
 	}
 
-	return nil, errors.New("bind failed: all allocated addresses in use")
+	// DYNAMIC pools may grow by taking the next free address from the network
+	if pm.pool.Type != api.Pool_DYNAMIC {
+		return nil, errorf(ErrExhausted, "bind failed: all allocated addresses in use, %d quarantined", quarantined)
+	}
+
+	// fail early on a full pool, the maximum itself is enforced by the bind transaction
+	if uint64(len(existingBindings)) >= pm.MaxSize() {
+		return nil, errorf(ErrExhausted, "bind failed: maximum addresses reached, %d quarantined", quarantined)
+	}
+
+	binding := newBinding(&api.Binding{
+		PoolID:      pm.pool.ID,
+		ID:          newBindingID(),
+		Annotations: annotations,
+		AffinityKey: o.affinityKey,
+		Owner:       o.owner,
+	})
+
+	err = pm.bindNextBinding(binding, ttl, o)
+	if err != nil {
+		return nil, errors.Wrap(err, "binding next address failed")
+	}
+
+	return binding.Binding, nil
+}
+
+func (pm *etcdPoolManager) BindMany(annotations []map[string]string, ttl int64, opts ...BindOption) (_ []*api.Binding, err error) {
+	o := newBindOptions(opts)
+	if len(annotations) == 0 {
+		return nil, errorf(ErrInvalidArgument, "bind failed: no bindings requested")
+	}
+
+	// the bindings share one lease across every attempt, it is dropped if none commits
+	var lease storage.LeaseID
+	leaseOp := pm.sharedLeaseOp(ttl, &lease)
+	defer func() {
+		if err == nil || lease == storage.NoLease {
+			return
+		}
+		if rerr := pm.store.Revoke(context.TODO(), lease); rerr != nil {
+			plog.Warningf("failed to revoke binding lease %d: %v", lease, rerr)
+		}
+	}()
+
+	for retry := 0; ; retry++ {
+		existingBindings, err := pm.listBindings(nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "list bindings failed")
+		}
+
+		released := filterBoundBindings(existingBindings)
+		orderBindings(pm.pool.Strategy, released, o.affinityKey)
+
+		now := time.Now()
+		bindings := []*etcdBinding{}
+		for _, binding := range released {
+			if len(bindings) == len(annotations) {
+				break
+			}
+			if !o.ignoreQuarantine && binding.isQuarantined(now) {
+				continue
+			}
+			bindings = append(bindings, binding)
+		}
+
+		// DYNAMIC pools may grow by the addresses missing
+		var cmps []storage.Cmp
+		var ops []storage.Op
+		if missing := len(annotations) - len(bindings); missing > 0 {
+			if pm.pool.Type != api.Pool_DYNAMIC {
+				return nil, errorf(ErrExhausted, "bind failed: %d of %d addresses available", len(bindings), len(annotations))
+			}
+			if pm.ipam == nil {
+				return nil, errors.New("network has no ipam to allocate from")
+			}
+
+			cmps, ops, err = pm.growOps(missing)
+			if err != nil {
+				return nil, errors.Wrap(err, "bind failed")
+			}
+
+			addrs, allocCmps, allocOps, err := pm.ipam.AllocateNOp(missing)
+			if err != nil {
+				return nil, errors.Wrap(err, "allocating addresses failed")
+			}
+			cmps, ops = append(cmps, allocCmps...), append(ops, allocOps...)
+			for _, addr := range addrs {
+				bindings = append(bindings, newBinding(&api.Binding{
+					PoolID:  pm.pool.ID,
+					ID:      newBindingID(),
+					Address: addr.String(),
+				}))
+			}
+		}
+
+		timestamp := time.Now().UTC().UnixNano()
+		for idx, binding := range bindings {
+			binding.Annotations = mergeMap(pm.pool.Annotations, annotations[idx])
+			binding.AffinityKey = o.affinityKey
+			binding.Owner = ""
+			binding.BindTime = timestamp
+			binding.Ttl = ttl
+			binding.QuarantineUntil = 0
+		}
+
+		committed, err := pm.writeBindings(bindings, leaseOp, cmps, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		if committed {
+			apiBindings := make([]*api.Binding, len(bindings))
+			for idx := range bindings {
+				apiBindings[idx] = bindings[idx].Binding
+			}
+			return apiBindings, nil
+		}
+		bindingCASFailures.Inc()
+
+		if retry >= ipam.PostalIPAMRetryMax {
+			return nil, errorf(ErrConflict, "etcd transaction failed")
+		}
+		bindingTxnRetries.Inc()
+		time.Sleep(ipam.RetryBackoff(retry + 1))
+	}
 }
 
-func (pm *etcdPoolManager) Bind(annotations map[string]string, requestedAddress net.IP) (*api.Binding, error) {
+func (pm *etcdPoolManager) Bind(annotations map[string]string, requestedAddress net.IP, ttl int64, opts ...BindOption) (*api.Binding, error) {
+	o := newBindOptions(opts)
 	annotations = mergeMap(pm.pool.Annotations, annotations)
 	binding := newBinding(&api.Binding{
 		PoolID:      pm.pool.ID,
 		ID:          newBindingID(),
 		Annotations: annotations,
+		AffinityKey: o.affinityKey,
+		Owner:       o.owner,
 	})
 
 	if requestedAddress == nil || requestedAddress.IsUnspecified() {
-		return nil, errors.New("bind failed: requestedAddress is unspecified")
+		return nil, errorf(ErrInvalidArgument, "bind failed: requestedAddress is unspecified")
+	}
+
+	if o.owner != "" {
+		owned, err := pm.bindOwned(annotations, requestedAddress, ttl, o)
+		if owned != nil || err != nil {
+			return owned, err
+		}
 	}
 
 	// Check existing bindings for requested address
 	addrBinding, err := pm.getBindingForAddr(requestedAddress)
 	if addrBinding != nil && !addrBinding.isBound() {
-		err = pm.rebindBinding(addrBinding, annotations)
+		now := time.Now()
+		if !o.ignoreQuarantine && addrBinding.isQuarantined(now) {
+			return nil, errorf(ErrFailedPrecondition, "bind failed: address is quarantined for another %s",
+				time.Duration(addrBinding.QuarantineUntil-now.UnixNano()))
+		}
+		err = pm.rebindBinding(addrBinding, annotations, ttl, o)
 		if err == nil {
 			return addrBinding.Binding, nil
 		}
-		return nil, fmt.Errorf("address already bound")
+		return nil, errorf(ErrAlreadyExists, "address already bound")
 	}
 
 	if pm.pool.Type == api.Pool_FIXED {
-		return nil, errors.New("bind failed: all allocated addresses in use")
-	}
-
-	if pm.CurrentSize() >= pm.MaxSize() {
-		return nil, errors.New("allocate failed: maximum addresses reached")
+		return nil, errorf(ErrExhausted, "bind failed: all allocated addresses in use")
 	}
 
-	err = pm.bindBinding(binding, requestedAddress)
+	err = pm.bindBinding(binding, requestedAddress, ttl, o)
 	if err != nil {
 		return nil, errors.Wrap(err, "binding address failed")
 	}
@@ -188,7 +401,7 @@ func (pm *etcdPoolManager) Release(b *api.Binding, hard bool) error {
 	}
 
 	if binding.ReleaseTime > binding.BindTime {
-		return errors.New("cannot release binding, already released")
+		return errorf(ErrFailedPrecondition, "cannot release binding, already released")
 	}
 
 	err = pm.releaseBinding(binding, NoTTL)
@@ -199,6 +412,28 @@ func (pm *etcdPoolManager) Release(b *api.Binding, hard bool) error {
 	return nil
 }
 
+func (pm *etcdPoolManager) Renew(ID string) (*api.Binding, error) {
+	binding, err := pm.getBinding(ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get binding")
+	}
+
+	if !binding.isBound() {
+		return nil, errorf(ErrFailedPrecondition, "cannot renew binding, not bound")
+	}
+
+	if binding.Ttl <= NoTTL {
+		return nil, errorf(ErrFailedPrecondition, "cannot renew binding, bound without a ttl")
+	}
+
+	err = pm.renewBinding(binding)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to renew binding")
+	}
+
+	return binding.Binding, nil
+}
+
 func (pm *etcdPoolManager) Binding(ID string) (*api.Binding, error) {
 	binding, err := pm.getBinding(ID)
 	if err != nil {
@@ -208,27 +443,47 @@ func (pm *etcdPoolManager) Binding(ID string) (*api.Binding, error) {
 	return binding.Binding, nil
 }
 
-func (pm *etcdPoolManager) CurrentSize() uint64 {
-	var count uint64
-	resp, err := pm.etcd.KV.Get(context.Background(), bindingListKey(pm.pool.ID.NetworkID, pm.pool.ID.ID), clientv3.WithPrefix())
+func (pm *etcdPoolManager) CurrentSize() (uint64, error) {
+	resp, err := pm.store.Get(
+		context.Background(),
+		bindingListKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)+"/",
+		storage.WithPrefix(),
+		storage.WithCountOnly())
 	if err != nil {
-		return count
+		return 0, errors.Wrap(err, "etcd kv count failed")
 	}
 
-	count += uint64(len(resp.Kvs))
-	for resp.More {
-		resp, err = pm.etcd.KV.Get(
-			context.Background(),
-			string(resp.Kvs[len(resp.Kvs)].Key),
-			clientv3.WithPrefix(),
-			clientv3.WithFromKey())
-		if err != nil {
-			return count
-		}
-		count += uint64(len(resp.Kvs))
+	return uint64(resp.Count), nil
+}
+
+// size returns the current size of the pool along with the version of its size key,
+// which every transaction growing the pool compares and bumps. The version is read
+// first, so a growth the size misses fails a transaction comparing it.
+func (pm *etcdPoolManager) size() (int64, uint64, error) {
+	version, err := pm.sizeVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size, err := pm.CurrentSize()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return version, size, nil
+}
+
+// sizeVersion returns the version of the pool's size key.
+func (pm *etcdPoolManager) sizeVersion() (int64, error) {
+	resp, err := pm.store.Get(context.Background(), poolSizeKey(pm.pool.ID.NetworkID, pm.pool.ID.ID))
+	if err != nil {
+		return 0, errors.Wrap(err, "etcd kv get failed")
 	}
 
-	return count
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	return resp.Kvs[0].Version, nil
 }
 
 func (pm *etcdPoolManager) MaxSize() uint64 {
@@ -236,17 +491,89 @@ func (pm *etcdPoolManager) MaxSize() uint64 {
 }
 
 func (pm *etcdPoolManager) SetMaxSize(max uint64) error {
-	if pm.CurrentSize() > max {
-		return errors.New("current size exceeds new maximum")
+	sizeVersion, size, err := pm.size()
+	if err != nil {
+		return err
+	}
+
+	if size > max {
+		return errorf(ErrFailedPrecondition, "current size exceeds new maximum")
 	}
 	oldData, _ := json.Marshal(pm.pool)
+	pool := *pm.pool
+	pool.MaximumAddresses = max
+	newData, _ := json.Marshal(&pool)
+
+	// the size key is bumped as well, failing binds that grow the pool under the old maximum
+	sizeKey := poolSizeKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)
+	resp, err := pm.store.Txn(context.TODO()).If(
+		storage.Compare(
+			storage.Value(poolMetaKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)),
+			"=", string(oldData),
+		),
+		storage.Compare(storage.Version(sizeKey), "=", sizeVersion),
+	).Then(
+		storage.OpPut(poolMetaKey(pm.pool.ID.NetworkID, pm.pool.ID.ID), string(newData)),
+		storage.OpPut(sizeKey, strconv.FormatUint(size, 10)),
+	).Commit()
+	if err != nil {
+		return errors.Wrap(err, "etcd transaction error")
+	}
+
+	if !resp.Succeeded {
+		return errorf(ErrConflict, "pool %s changed while setting its maximum", pm.pool.ID.ID)
+	}
+
 	pm.pool.MaximumAddresses = max
-	newData, _ := json.Marshal(pm.pool)
+	return nil
+}
+
+// remove hard releases every binding of the pool and deletes the pool.
+// Unless force is set, it fails without releasing anything if a binding is bound.
+// It fails with ErrConflict if the pool grows while it is removed.
+func (pm *etcdPoolManager) remove(force bool) error {
+	// binds growing the pool bump its size key, so comparing the version read before
+	// the listing fails the delete below if it would wipe a binding the listing missed
+	sizeVersion, err := pm.sizeVersion()
+	if err != nil {
+		return err
+	}
+
+	bindings, err := pm.listBindings(nil)
+	if err != nil {
+		return errors.Wrap(err, "list bindings failed")
+	}
+
+	if !force {
+		for idx := range bindings {
+			if bindings[idx].isBound() {
+				return errorf(ErrFailedPrecondition, "pool %s has bound addresses", pm.pool.ID.ID)
+			}
+		}
+	}
+
+	for idx := range bindings {
+		err = pm.releaseBinding(bindings[idx], HardRelease)
+		if err != nil {
+			return errors.Wrapf(err, "failed to hard release binding %s", bindings[idx].ID)
+		}
+	}
+
+	resp, err := pm.store.Txn(context.TODO()).If(
+		storage.Compare(storage.Version(poolSizeKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)), "=", sizeVersion),
+	).Then(
+		storage.OpDelete(poolMetaKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)),
+		storage.OpDelete(bindingListKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)+"/", storage.WithPrefix()),
+		storage.OpDelete(ownersKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)+"/", storage.WithPrefix()),
+		storage.OpDelete(poolSizeKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)),
+	).Commit()
+	if err != nil {
+		return errors.Wrap(err, "etcd transaction error")
+	}
+
+	if !resp.Succeeded {
+		return errorf(ErrConflict, "pool %s grew while it was removed", pm.pool.ID.ID)
+	}
 
-	pm.etcd.KV.Txn(context.TODO()).If(
-		clientv3.Compare(
-			clientv3.Value(poolMetaKey(pm.pool.ID.NetworkID, pm.pool.ID.ID)),
-			"=", string(oldData),
-		)).Then(clientv3.OpPut(poolMetaKey(pm.pool.ID.NetworkID, pm.pool.ID.ID), string(newData))).Commit()
 	return nil
 }
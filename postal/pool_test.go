@@ -17,6 +17,7 @@ limitations under the License.
 package postal
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"testing"
@@ -24,39 +25,38 @@ import (
 
 	"golang.org/x/net/context"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/pkg/capnslog"
 	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage"
+	"github.com/jive/postal/storage/storagetest"
 	"github.com/stretchr/testify/assert"
 )
 
-func mkPool(etcd *clientv3.Client, cidr string) *etcdPoolManager {
-	return &etcdPoolManager{
-		etcd: etcd,
-		pool: &api.Pool{
-			ID: &api.Pool_PoolID{
-				NetworkID: "network1",
-				ID:        "pool1",
-			},
-			Annotations:      map[string]string{"foo": "bar"},
-			MaximumAddresses: 5,
-			Type:             api.Pool_FIXED,
+func mkPool(store storage.Store, cidr string) *etcdPoolManager {
+	pool := &api.Pool{
+		ID: &api.Pool_PoolID{
+			NetworkID: "network1",
+			ID:        "pool1",
 		},
+		Annotations:      map[string]string{"foo": "bar"},
+		MaximumAddresses: 5,
+		Type:             api.Pool_FIXED,
+	}
+	data, _ := json.Marshal(pool)
+	store.Put(context.TODO(), poolMetaKey(pool.ID.NetworkID, pool.ID.ID), string(data))
+
+	return &etcdPoolManager{
+		store: store,
+		pool:  pool,
 	}
 }
 
 func TestAllocate(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	nm, err := (&Config{}).WithEtcdClient(cli).NewNetwork(nil, "10.0.0.0/24")
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
 	assert.NoError(err)
 
 	pool, err := nm.NewPool(nil, 5, api.Pool_FIXED)
@@ -82,16 +82,10 @@ func TestAllocate(t *testing.T) {
 
 func TestAllocateMuliplePools(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	nm, err := (&Config{}).WithEtcdClient(cli).NewNetwork(nil, "10.0.0.0/24")
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
 	assert.NoError(err)
 
 	pool1, err := nm.NewPool(nil, 5, api.Pool_FIXED)
@@ -111,16 +105,10 @@ func TestAllocateMuliplePools(t *testing.T) {
 func TestReleaseHard(t *testing.T) {
 	capnslog.SetGlobalLogLevel(capnslog.DEBUG)
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
-
-	nm, err := (&Config{}).WithEtcdClient(cli).NewNetwork(nil, "10.0.0.0/24")
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
 	assert.NoError(err)
 
 	pool, err := nm.NewPool(nil, 5, api.Pool_FIXED)
@@ -150,30 +138,366 @@ func TestReleaseHard(t *testing.T) {
 
 func TestSetMaxSize(t *testing.T) {
 	assert := assert.New(t)
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
-
-	pool := mkPool(cli, "10.0.0.0/24")
+	pool := mkPool(store, "10.0.0.0/24")
 	for i := uint64(0); i < pool.MaxSize(); i++ {
-		_, err = pool.Allocate(net.ParseIP(fmt.Sprintf("10.0.0.%d", i)))
+		_, err := pool.Allocate(net.ParseIP(fmt.Sprintf("10.0.0.%d", i)))
 		assert.NoError(err)
 	}
 
-	err = pool.SetMaxSize(2)
+	err := pool.SetMaxSize(2)
 	assert.Error(err)
 
+	stalePool := *pool.pool
+	stale := &etcdPoolManager{store: store, pool: &stalePool}
+
 	err = pool.SetMaxSize(6)
 	assert.NoError(err)
 
+	// a manager holding the pool from before the update loses against it
+	assert.Equal(ErrConflict, ErrorKindOf(stale.SetMaxSize(8)))
+	assert.Equal(uint64(5), stale.MaxSize())
+
 	_, err = pool.Allocate(net.ParseIP("10.0.0.100"))
 	assert.NoError(err)
 
 	_, err = pool.Allocate(net.ParseIP("10.0.0.101"))
 	assert.Error(err)
 }
+
+func TestAllocateOutOfRange(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	pool, err := nm.NewPool(nil, 5, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	_, err = pool.Allocate(net.ParseIP("10.0.1.1"))
+	assert.Error(err)
+
+	_, err = pool.Allocate(net.ParseIP("10.0.0.0"))
+	assert.Error(err)
+
+	_, err = pool.Allocate(net.ParseIP("10.0.0.255"))
+	assert.Error(err)
+
+	_, err = pool.Bind(nil, net.ParseIP("10.0.1.1"), NoTTL)
+	assert.Error(err)
+
+	binding, err := pool.Bind(nil, net.ParseIP("10.0.0.254"), NoTTL)
+	assert.NoError(err)
+	assert.NotNil(binding)
+}
+
+func TestBindAnyDynamicGrowth(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	pool, err := nm.NewPool(nil, 2, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	binding1, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.Equal("10.0.0.1", binding1.Address)
+
+	binding2, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.Equal("10.0.0.2", binding2.Address)
+
+	_, err = pool.BindAny(nil, NoTTL)
+	assert.Error(err)
+
+	// a released binding is reused before the pool grows again
+	assert.NoError(pool.Release(binding1, false))
+	binding3, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.Equal(binding1.Address, binding3.Address)
+
+	fixed, err := nm.NewPool(nil, 2, api.Pool_FIXED)
+	assert.NoError(err)
+
+	_, err = fixed.BindAny(nil, NoTTL)
+	assert.Error(err)
+}
+
+func TestBindingLease(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go config.ExpireBindings(ctx)
+
+	nm, err := config.NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	pool, err := nm.NewPool(nil, 2, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	leased, err := pool.BindAny(nil, 2)
+	assert.NoError(err)
+	assert.Equal(int64(2), leased.Ttl)
+
+	unleased, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+
+	_, err = pool.Renew(unleased.ID)
+	assert.Error(err)
+
+	// renewing keeps the binding bound past its original ttl
+	for i := 0; i < 3; i++ {
+		time.Sleep(time.Second)
+		_, err = pool.Renew(leased.ID)
+		assert.NoError(err)
+	}
+
+	binding, err := pool.Binding(leased.ID)
+	assert.NoError(err)
+	assert.True(binding.BindTime > binding.ReleaseTime)
+
+	// without renewal the lease expires and the binding is released
+	time.Sleep(5 * time.Second)
+
+	binding, err = pool.Binding(leased.ID)
+	assert.NoError(err)
+	assert.True(binding.ReleaseTime > binding.BindTime)
+
+	_, err = pool.Renew(leased.ID)
+	assert.Error(err)
+
+	binding, err = pool.Binding(unleased.ID)
+	assert.NoError(err)
+	assert.True(binding.BindTime > binding.ReleaseTime)
+}
+
+func TestBindQuarantine(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	pool, err := nm.NewPool(nil, 1, api.Pool_DYNAMIC, WithQuarantine(3600))
+	assert.NoError(err)
+	assert.Equal(int64(3600), pool.APIPool().Quarantine)
+
+	binding, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.Zero(binding.QuarantineUntil)
+	assert.NoError(pool.Release(binding, false))
+
+	released, err := pool.Binding(binding.ID)
+	assert.NoError(err)
+	assert.Equal(released.ReleaseTime+3600*int64(time.Second), released.QuarantineUntil)
+
+	sel, err := ParseSelector("_status=quarantined")
+	assert.NoError(err)
+	assert.True(sel.MatchBinding(released))
+
+	// the quarantined binding is neither reused nor rebound
+	_, err = pool.BindAny(nil, NoTTL)
+	assert.Equal(ErrExhausted, ErrorKindOf(err))
+	_, err = pool.Bind(nil, net.ParseIP(binding.Address), NoTTL)
+	assert.Equal(ErrFailedPrecondition, ErrorKindOf(err))
+
+	rebound, err := pool.Bind(nil, net.ParseIP(binding.Address), NoTTL, IgnoreQuarantine())
+	assert.NoError(err)
+	assert.Equal(binding.ID, rebound.ID)
+	assert.Zero(rebound.QuarantineUntil)
+	assert.False(sel.MatchBinding(rebound))
+
+	// pools without a quarantine reuse released bindings right away
+	open, err := nm.NewPool(nil, 1, api.Pool_DYNAMIC)
+	assert.NoError(err)
+	binding, err = open.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.NoError(open.Release(binding, false))
+	reused, err := open.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.Equal(binding.ID, reused.ID)
+}
+
+func TestBindOwner(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	pool, err := nm.NewPool(nil, 5, api.Pool_DYNAMIC, WithQuarantine(3600))
+	assert.NoError(err)
+
+	binding, err := pool.BindAny(nil, NoTTL, WithOwner("proxy/1"))
+	assert.NoError(err)
+	assert.Equal("proxy/1", binding.Owner)
+
+	// binding again for the owner returns the binding it holds
+	again, err := pool.BindAny(nil, NoTTL, WithOwner("proxy/1"))
+	assert.NoError(err)
+	assert.Equal(binding.ID, again.ID)
+	again, err = pool.Bind(nil, net.ParseIP(binding.Address), NoTTL, WithOwner("proxy/1"))
+	assert.NoError(err)
+	assert.Equal(binding.ID, again.ID)
+	_, err = pool.Bind(nil, net.ParseIP("10.0.0.99"), NoTTL, WithOwner("proxy/1"))
+	assert.Equal(ErrAlreadyExists, ErrorKindOf(err))
+
+	other, err := pool.BindAny(nil, NoTTL, WithOwner("proxy-2"))
+	assert.NoError(err)
+	assert.NotEqual(binding.Address, other.Address)
+
+	// the owner's released address comes back, even while quarantined
+	assert.NoError(pool.Release(binding, false))
+	again, err = pool.BindAny(nil, NoTTL, WithOwner("proxy/1"))
+	assert.NoError(err)
+	assert.Equal(binding.ID, again.ID)
+	assert.Equal(binding.Address, again.Address)
+
+	// once someone else holds the address, the owner gets a new one
+	assert.NoError(pool.Release(again, false))
+	taken, err := pool.Bind(nil, net.ParseIP(binding.Address), NoTTL, IgnoreQuarantine())
+	assert.NoError(err)
+	assert.Empty(taken.Owner)
+	again, err = pool.BindAny(nil, NoTTL, WithOwner("proxy/1"))
+	assert.NoError(err)
+	assert.NotEqual(binding.Address, again.Address)
+	assert.NotEqual(other.Address, again.Address)
+
+	// hard releasing the owner's binding drops it from the index
+	assert.NoError(pool.Release(again, true))
+	resp, err := store.Get(context.TODO(), ownerKey(nm.APINetwork().ID, pool.APIPool().ID.ID, "proxy/1"))
+	assert.NoError(err)
+	assert.Empty(resp.Kvs)
+}
+
+func TestBindMany(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	pool, err := nm.NewPool(map[string]string{"tier": "proxy"}, 4, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	released, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.NoError(pool.Release(released, false))
+
+	// released bindings are reused before new addresses are taken
+	bindings, err := pool.BindMany([]map[string]string{{"host": "a"}, {"host": "b"}, {"host": "c"}}, NoTTL)
+	assert.NoError(err)
+	if assert.Equal(3, len(bindings)) {
+		assert.Equal(released.ID, bindings[0].ID)
+		assert.Equal([]string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, []string{bindings[0].Address, bindings[1].Address, bindings[2].Address})
+		assert.Equal(map[string]string{"tier": "proxy", "host": "c"}, bindings[2].Annotations)
+		for _, binding := range bindings {
+			b, err := pool.Binding(binding.ID)
+			assert.NoError(err)
+			assert.True(b.BindTime > b.ReleaseTime)
+		}
+	}
+
+	// nothing is bound unless all of them can be
+	_, err = pool.BindMany([]map[string]string{nil, nil}, NoTTL)
+	assert.Equal(ErrExhausted, ErrorKindOf(err))
+	size, err := pool.CurrentSize()
+	assert.NoError(err)
+	assert.Equal(uint64(3), size)
+
+	_, err = pool.BindMany(nil, NoTTL)
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(err))
+}
+
+func TestBindManyConcurrentBind(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	hooked := &hookStore{Store: store, suffix: "/bindings/"}
+	nm, err := (&Config{}).WithStore(hooked).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+	pool, err := nm.NewPool(nil, 3, api.Pool_DYNAMIC)
+	assert.NoError(err)
+	_, err = pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+
+	// an address is bound right after the batch listed the bindings
+	hooked.hook = func() {
+		_, err := pool.BindAny(nil, NoTTL)
+		assert.NoError(err)
+	}
+	_, err = pool.BindMany([]map[string]string{nil, nil}, NoTTL)
+	assert.Equal(ErrExhausted, ErrorKindOf(err))
+
+	size, err := pool.CurrentSize()
+	assert.NoError(err)
+	assert.Equal(uint64(2), size)
+}
+
+// leaseCountingStore counts the leases granted and revoked through it.
+type leaseCountingStore struct {
+	storage.Store
+	grants, revokes int
+}
+
+func (s *leaseCountingStore) Grant(ctx context.Context, ttl int64) (storage.LeaseID, error) {
+	s.grants++
+	return s.Store.Grant(ctx, ttl)
+}
+
+func (s *leaseCountingStore) Revoke(ctx context.Context, id storage.LeaseID) error {
+	s.revokes++
+	return s.Store.Revoke(ctx, id)
+}
+
+func TestBindManySharesLease(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	hooked := &hookStore{Store: store, suffix: "/allocations"}
+	counted := &leaseCountingStore{Store: hooked}
+	nm, err := (&Config{}).WithStore(counted).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+	pool, err := nm.NewPool(nil, 5, api.Pool_DYNAMIC)
+	assert.NoError(err)
+	_, err = pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+
+	// the retry after a concurrent bind reuses the lease of the first attempt
+	hooked.hook = func() {
+		_, err := pool.BindAny(nil, NoTTL)
+		assert.NoError(err)
+	}
+	bindings, err := pool.BindMany([]map[string]string{nil, nil}, 60)
+	assert.NoError(err)
+	assert.Len(bindings, 2)
+	assert.Equal(1, counted.grants)
+	assert.Equal(0, counted.revokes)
+
+	// the lease is revoked when the batch is never written
+	hooked.hook = func() {
+		_, err := pool.BindAny(nil, NoTTL)
+		assert.NoError(err)
+	}
+	counted.grants = 0
+	_, err = pool.BindMany([]map[string]string{nil}, 60)
+	assert.Equal(ErrExhausted, ErrorKindOf(err))
+	assert.Equal(1, counted.grants)
+	assert.Equal(1, counted.revokes)
+}
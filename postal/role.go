@@ -0,0 +1,164 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// Roles returns the stored roles ordered by name.
+func (config *Config) Roles() ([]*api.Role, error) {
+	resp, err := config.store.Get(context.TODO(), rolesKey()+"/", storage.WithPrefix())
+	if err != nil {
+		return nil, errors.Wrap(err, "etcd kv range failed")
+	}
+
+	roles := make([]*api.Role, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		role := &api.Role{}
+		err = json.Unmarshal(kv.Value, role)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal role")
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// WatchRoles calls fn with the stored roles ordered by name, then again whenever
+// they change, until ctx is done or the watch fails.
+func (config *Config) WatchRoles(ctx context.Context, fn func([]*api.Role)) error {
+	resp, err := config.store.Get(ctx, rolesKey()+"/", storage.WithPrefix())
+	if err != nil {
+		return errors.Wrap(err, "etcd kv range failed")
+	}
+
+	roles := map[string]*api.Role{}
+	for _, kv := range resp.Kvs {
+		role := &api.Role{}
+		err = json.Unmarshal(kv.Value, role)
+		if err != nil {
+			return errors.Wrap(err, "failed to unmarshal role")
+		}
+		roles[string(kv.Key)] = role
+	}
+	fn(sortedRoles(roles))
+
+	wch := config.store.Watch(ctx, rolesKey()+"/", storage.WithPrefix(), storage.WithRev(resp.Revision+1))
+	for wresp := range wch {
+		if err := wresp.Err(); err != nil {
+			return errors.Wrap(err, "role watch failed")
+		}
+
+		for _, ev := range wresp.Events {
+			if ev.Type == storage.EventTypeDelete {
+				delete(roles, string(ev.Kv.Key))
+				continue
+			}
+
+			role := &api.Role{}
+			err = json.Unmarshal(ev.Kv.Value, role)
+			if err != nil {
+				return errors.Wrap(err, "failed to unmarshal role")
+			}
+			roles[string(ev.Kv.Key)] = role
+		}
+		fn(sortedRoles(roles))
+	}
+
+	return ctx.Err()
+}
+
+// sortedRoles returns the roles ordered by their keys, which is by name.
+func sortedRoles(roles map[string]*api.Role) []*api.Role {
+	keys := make([]string, 0, len(roles))
+	for key := range roles {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sorted := make([]*api.Role, len(keys))
+	for idx, key := range keys {
+		sorted[idx] = roles[key]
+	}
+	return sorted
+}
+
+// Role returns the stored role with the given name.
+func (config *Config) Role(name string) (*api.Role, error) {
+	resp, err := config.store.Get(context.TODO(), roleKey(name))
+	if err != nil {
+		return nil, errors.Wrap(err, "etcd kv get failed")
+	}
+
+	if len(resp.Kvs) != 1 {
+		return nil, errorf(ErrNotFound, "role %s could not be found", name)
+	}
+
+	role := &api.Role{}
+	err = json.Unmarshal(resp.Kvs[0].Value, role)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal role")
+	}
+
+	return role, nil
+}
+
+// SetRole stores the role, replacing the role of the same name.
+func (config *Config) SetRole(role *api.Role) error {
+	if len(role.Name) == 0 || strings.Contains(role.Name, "/") {
+		return errorf(ErrInvalidArgument, "role name %q must be non empty and must not contain /", role.Name)
+	}
+
+	data, err := json.Marshal(role)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal role")
+	}
+
+	_, err = config.store.Put(context.TODO(), roleKey(role.Name), string(data))
+	if err != nil {
+		return errors.Wrap(err, "etcd kv put failed")
+	}
+
+	return nil
+}
+
+// RemoveRole deletes the stored role with the given name.
+func (config *Config) RemoveRole(name string) error {
+	if len(name) == 0 {
+		return errorf(ErrInvalidArgument, "role name must be non empty")
+	}
+
+	resp, err := config.store.Delete(context.TODO(), roleKey(name))
+	if err != nil {
+		return errors.Wrap(err, "etcd kv delete failed")
+	}
+
+	if resp.Deleted == 0 {
+		return errorf(ErrNotFound, "role %s could not be found", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package postal
+
+import (
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoles(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+
+	roles, err := config.Roles()
+	assert.NoError(err)
+	assert.Equal(0, len(roles))
+
+	proxy := &api.Role{
+		Name:   "proxy",
+		Groups: []string{"proxies"},
+		Rules: []*api.Role_Rule{
+			{Methods: []string{"BindAddress", "ReleaseAddress"}, Pools: []string{"pool-x"}},
+		},
+	}
+	assert.NoError(config.SetRole(proxy))
+	assert.NoError(config.SetRole(&api.Role{Name: "dashboard", Users: []string{"grafana"}}))
+
+	role, err := config.Role("proxy")
+	assert.NoError(err)
+	assert.Equal(proxy, role)
+
+	// setting a role again replaces it
+	proxy.Users = []string{"haproxy"}
+	assert.NoError(config.SetRole(proxy))
+	role, err = config.Role("proxy")
+	assert.NoError(err)
+	assert.Equal([]string{"haproxy"}, role.Users)
+
+	roles, err = config.Roles()
+	assert.NoError(err)
+	assert.Equal(2, len(roles))
+	assert.Equal("dashboard", roles[0].Name)
+	assert.Equal("proxy", roles[1].Name)
+
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(config.SetRole(&api.Role{})))
+	assert.Equal(ErrInvalidArgument, ErrorKindOf(config.SetRole(&api.Role{Name: "a/b"})))
+
+	assert.NoError(config.RemoveRole("proxy"))
+	_, err = config.Role("proxy")
+	assert.Equal(ErrNotFound, ErrorKindOf(err))
+	assert.Equal(ErrNotFound, ErrorKindOf(config.RemoveRole("proxy")))
+}
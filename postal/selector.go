@@ -0,0 +1,584 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+)
+
+// Selector matches networks, pools and bindings against a list of requirements,
+// all of which must hold. A nil Selector matches everything.
+//
+// Selectors are written as comma separated requirements, similar to Kubernetes
+// label selectors:
+//
+//	key              the key exists
+//	!key             the key does not exist
+//	key=value        the key equals value, == is accepted as well
+//	key!=value       the key is missing or does not equal value
+//	key in (a,b)     the key equals one of the values
+//	key notin (a,b)  the key is missing or equals none of the values
+//	key=~regexp      the key matches the regular expression
+//	key!~regexp      the key is missing or does not match the regular expression
+//	key<time         the time is before, <=, > and >= work the same way
+//
+// Keys are annotations, or builtins starting with an underscore:
+//
+//	_id              ID of the network, pool or binding
+//	_cidr            cidr of a network
+//	_network         network ID of a pool or binding
+//	_pool            pool ID of a binding
+//	_type            type of a pool, dynamic or fixed
+//	_address         address of a binding, in and notin also accept cidrs
+//	_status          status of a binding, bound, quarantined or available
+//	_allocated       allocate time of a binding
+//	_bound           bind time of a binding
+//	_released        release time of a binding
+//
+// Times are RFC3339 timestamps or durations, which are taken as that long ago.
+// A binding that was never bound or released has no _bound or _released time.
+type Selector struct {
+	requirements []requirement
+}
+
+type selectorOp int
+
+const (
+	opExists selectorOp = iota
+	opNotExists
+	opEquals
+	opNotEquals
+	opIn
+	opNotIn
+	opMatches
+	opNotMatches
+	opBefore
+	opNotAfter
+	opAfter
+	opNotBefore
+)
+
+var selectorOps = []struct {
+	token string
+	op    selectorOp
+}{
+	// longer tokens first, so = does not shadow ==
+	{"==", opEquals},
+	{"!=", opNotEquals},
+	{"=~", opMatches},
+	{"!~", opNotMatches},
+	{"<=", opNotAfter},
+	{">=", opNotBefore},
+	{"=", opEquals},
+	{"<", opBefore},
+	{">", opAfter},
+}
+
+var timeKeys = map[string]bool{
+	"_allocated": true,
+	"_bound":     true,
+	"_released":  true,
+}
+
+var statusValues = map[string]bool{
+	"bound":       true,
+	"quarantined": true,
+	"available":   true,
+}
+
+type requirement struct {
+	key    string
+	op     selectorOp
+	values []string
+	nets   []*net.IPNet
+	regexp *regexp.Regexp
+	time   time.Time
+}
+
+// NewSelector compiles the selector expression together with legacy filters,
+// which require each key to match its regular expression.
+func NewSelector(selector string, filters map[string]string) (*Selector, error) {
+	sel, err := ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	// sorted so the requirements and their errors do not depend on map order
+	keys := []string{}
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		req, err := newRequirement(key, opMatches, []string{filters[key]})
+		if err != nil {
+			return nil, errorf(ErrInvalidArgument, "failed to compile filter '%s': %v", filters[key], err)
+		}
+		sel.requirements = append(sel.requirements, req)
+	}
+
+	return sel, nil
+}
+
+// ParseSelector compiles a selector expression. An empty expression matches everything.
+func ParseSelector(selector string) (*Selector, error) {
+	p := &selectorParser{s: selector}
+	sel := &Selector{}
+
+	p.skipSpace()
+	for !p.done() {
+		req, err := p.requirement()
+		if err != nil {
+			return nil, errorf(ErrInvalidArgument, "invalid selector '%s': %v", selector, err)
+		}
+		sel.requirements = append(sel.requirements, req)
+
+		p.skipSpace()
+		if p.done() {
+			break
+		}
+		if p.peek() != ',' {
+			return nil, errorf(ErrInvalidArgument, "invalid selector '%s': expected ',' at position %d", selector, p.pos)
+		}
+		p.pos++
+		p.skipSpace()
+		if p.done() {
+			return nil, errorf(ErrInvalidArgument, "invalid selector '%s': expected requirement after ','", selector)
+		}
+	}
+
+	return sel, nil
+}
+
+func newRequirement(key string, op selectorOp, values []string) (requirement, error) {
+	req := requirement{key: key, op: op, values: values}
+
+	if key == "_type" || key == "_status" {
+		for idx := range req.values {
+			req.values[idx] = strings.ToLower(req.values[idx])
+		}
+	}
+
+	switch op {
+	case opEquals, opNotEquals, opIn, opNotIn:
+		if timeKeys[key] {
+			return req, errors.Errorf("%s only supports existence and time comparisons", key)
+		}
+		if key == "_status" {
+			for _, val := range req.values {
+				if !statusValues[val] {
+					return req, errors.Errorf("unknown status '%s'", val)
+				}
+			}
+		}
+		if key == "_address" && (op == opIn || op == opNotIn) {
+			for _, val := range req.values {
+				if _, ipnet, err := net.ParseCIDR(val); err == nil {
+					req.nets = append(req.nets, ipnet)
+				} else if net.ParseIP(val) == nil {
+					return req, errors.Errorf("'%s' is neither an address nor a cidr", val)
+				}
+			}
+		}
+	case opMatches, opNotMatches:
+		re, err := regexp.Compile(req.values[0])
+		if err != nil {
+			return req, err
+		}
+		req.regexp = re
+	case opBefore, opNotAfter, opAfter, opNotBefore:
+		if !timeKeys[key] {
+			return req, errors.Errorf("%s does not support time comparisons", key)
+		}
+		t, err := parseSelectorTime(req.values[0])
+		if err != nil {
+			return req, err
+		}
+		req.time = t
+	}
+
+	return req, nil
+}
+
+func parseSelectorTime(val string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, val); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(val); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Time{}, errors.Errorf("'%s' is neither an RFC3339 time nor a duration", val)
+}
+
+// fields gives requirements access to the keys of a resource.
+type fields interface {
+	field(key string) (string, bool)
+	timeField(key string) (time.Time, bool)
+}
+
+func (req *requirement) matches(f fields) bool {
+	switch req.op {
+	case opBefore, opNotAfter, opAfter, opNotBefore:
+		t, ok := f.timeField(req.key)
+		if !ok {
+			return false
+		}
+		switch req.op {
+		case opBefore:
+			return t.Before(req.time)
+		case opNotAfter:
+			return !t.After(req.time)
+		case opAfter:
+			return t.After(req.time)
+		default:
+			return !t.Before(req.time)
+		}
+	}
+
+	var val string
+	var ok bool
+	if timeKeys[req.key] {
+		_, ok = f.timeField(req.key)
+	} else {
+		val, ok = f.field(req.key)
+	}
+
+	switch req.op {
+	case opExists:
+		return ok
+	case opNotExists:
+		return !ok
+	case opEquals:
+		return ok && val == req.values[0]
+	case opNotEquals:
+		return !ok || val != req.values[0]
+	case opIn:
+		return ok && req.in(val)
+	case opNotIn:
+		return !ok || !req.in(val)
+	case opMatches:
+		return ok && req.regexp.MatchString(val)
+	case opNotMatches:
+		return !ok || !req.regexp.MatchString(val)
+	}
+	return false
+}
+
+func (req *requirement) in(val string) bool {
+	for _, v := range req.values {
+		if v == val {
+			return true
+		}
+	}
+
+	if len(req.nets) > 0 {
+		if ip := net.ParseIP(val); ip != nil {
+			for _, ipnet := range req.nets {
+				if ipnet.Contains(ip) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (sel *Selector) matches(f fields) bool {
+	if sel == nil {
+		return true
+	}
+	for idx := range sel.requirements {
+		if !sel.requirements[idx].matches(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchNetwork reports whether the network matches the selector.
+func (sel *Selector) MatchNetwork(network *api.Network) bool {
+	return sel.matches(networkFields{network})
+}
+
+// MatchPool reports whether the pool matches the selector.
+func (sel *Selector) MatchPool(pool *api.Pool) bool {
+	return sel.matches(poolFields{pool})
+}
+
+// MatchBinding reports whether the binding matches the selector.
+func (sel *Selector) MatchBinding(binding *api.Binding) bool {
+	return sel.matches(bindingFields{binding})
+}
+
+// matchEvent matches the resource the event is about.
+func (sel *Selector) matchEvent(event *api.Event) bool {
+	switch {
+	case event.Network != nil:
+		return sel.MatchNetwork(event.Network)
+	case event.Pool != nil:
+		return sel.MatchPool(event.Pool)
+	case event.Binding != nil:
+		return sel.MatchBinding(event.Binding)
+	}
+	return false
+}
+
+type networkFields struct {
+	*api.Network
+}
+
+func (n networkFields) field(key string) (string, bool) {
+	switch key {
+	case "_id":
+		return n.ID, true
+	case "_cidr":
+		return n.Cidr, true
+	}
+	val, ok := n.Annotations[key]
+	return val, ok
+}
+
+func (n networkFields) timeField(key string) (time.Time, bool) {
+	return time.Time{}, false
+}
+
+type poolFields struct {
+	*api.Pool
+}
+
+func (p poolFields) field(key string) (string, bool) {
+	switch key {
+	case "_id":
+		return p.ID.ID, true
+	case "_network":
+		return p.ID.NetworkID, true
+	case "_type":
+		return strings.ToLower(p.Type.String()), true
+	}
+	val, ok := p.Annotations[key]
+	return val, ok
+}
+
+func (p poolFields) timeField(key string) (time.Time, bool) {
+	return time.Time{}, false
+}
+
+type bindingFields struct {
+	*api.Binding
+}
+
+func (b bindingFields) field(key string) (string, bool) {
+	switch key {
+	case "_id":
+		return b.ID, true
+	case "_pool":
+		return b.PoolID.ID, true
+	case "_network":
+		return b.PoolID.NetworkID, true
+	case "_address":
+		return b.Address, true
+	case "_status":
+		eb := &etcdBinding{Binding: b.Binding}
+		if eb.isBound() {
+			return "bound", true
+		}
+		if eb.isQuarantined(time.Now()) {
+			return "quarantined", true
+		}
+		return "available", true
+	}
+	val, ok := b.Annotations[key]
+	return val, ok
+}
+
+func (b bindingFields) timeField(key string) (time.Time, bool) {
+	var nanos int64
+	switch key {
+	case "_allocated":
+		nanos = b.AllocateTime
+	case "_bound":
+		nanos = b.BindTime
+	case "_released":
+		nanos = b.ReleaseTime
+	}
+	if nanos == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+// selectorParser reads requirements from a selector expression.
+type selectorParser struct {
+	s   string
+	pos int
+}
+
+func (p *selectorParser) done() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *selectorParser) peek() byte {
+	return p.s[p.pos]
+}
+
+func (p *selectorParser) skipSpace() {
+	for !p.done() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+func (p *selectorParser) requirement() (requirement, error) {
+	if p.peek() == '!' {
+		p.pos++
+		p.skipSpace()
+		key, err := p.key()
+		if err != nil {
+			return requirement{}, err
+		}
+		return newRequirement(key, opNotExists, nil)
+	}
+
+	key, err := p.key()
+	if err != nil {
+		return requirement{}, err
+	}
+
+	p.skipSpace()
+	if p.done() || p.peek() == ',' {
+		return newRequirement(key, opExists, nil)
+	}
+
+	for _, word := range []struct {
+		token string
+		op    selectorOp
+	}{{"notin", opNotIn}, {"in", opIn}} {
+		if p.word(word.token) {
+			values, err := p.set()
+			if err != nil {
+				return requirement{}, err
+			}
+			return newRequirement(key, word.op, values)
+		}
+	}
+
+	for _, op := range selectorOps {
+		if strings.HasPrefix(p.s[p.pos:], op.token) {
+			p.pos += len(op.token)
+			p.skipSpace()
+
+			var value string
+			if op.op == opMatches || op.op == opNotMatches {
+				value = p.regexp()
+			} else {
+				value = p.value(",")
+			}
+			return newRequirement(key, op.op, []string{value})
+		}
+	}
+
+	return requirement{}, errors.Errorf("expected operator at position %d", p.pos)
+}
+
+func isKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '.' || c == '-' || c == '_' || c == '/'
+}
+
+func (p *selectorParser) key() (string, error) {
+	start := p.pos
+	for !p.done() && isKeyChar(p.peek()) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", errors.Errorf("expected key at position %d", p.pos)
+	}
+	return p.s[start:p.pos], nil
+}
+
+// word consumes the word if it is next and not the start of a longer key.
+func (p *selectorParser) word(word string) bool {
+	rest := p.s[p.pos:]
+	if !strings.HasPrefix(rest, word) || (len(rest) > len(word) && isKeyChar(rest[len(word)])) {
+		return false
+	}
+	p.pos += len(word)
+	p.skipSpace()
+	return true
+}
+
+// value reads up to the next of the stop characters, without surrounding spaces.
+func (p *selectorParser) value(stop string) string {
+	start := p.pos
+	for !p.done() && strings.IndexByte(stop, p.peek()) < 0 {
+		p.pos++
+	}
+	return strings.TrimSpace(p.s[start:p.pos])
+}
+
+// regexp reads up to the next comma outside of brackets, so regexps may contain commas.
+func (p *selectorParser) regexp() string {
+	start := p.pos
+	depth := 0
+	for ; !p.done(); p.pos++ {
+		switch p.peek() {
+		case '\\':
+			p.pos++
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ',':
+			if depth <= 0 {
+				return strings.TrimSpace(p.s[start:p.pos])
+			}
+		}
+	}
+	if p.pos > len(p.s) {
+		p.pos = len(p.s)
+	}
+	return strings.TrimSpace(p.s[start:p.pos])
+}
+
+// set reads a parenthesized, comma separated list of values.
+func (p *selectorParser) set() ([]string, error) {
+	if p.done() || p.peek() != '(' {
+		return nil, errors.Errorf("expected '(' at position %d", p.pos)
+	}
+	p.pos++
+
+	values := []string{}
+	for {
+		p.skipSpace()
+		values = append(values, p.value(",)"))
+		if p.done() {
+			return nil, errors.New("missing ')'")
+		}
+		if p.peek() == ')' {
+			p.pos++
+			return values, nil
+		}
+		p.pos++
+	}
+}
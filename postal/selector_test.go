@@ -0,0 +1,159 @@
+package postal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func filterSelector(t *testing.T, filters map[string]string) *Selector {
+	sel, err := NewSelector("", filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sel
+}
+
+func TestParseSelector(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, expr := range []string{
+		"",
+		"env",
+		"!env",
+		"env=prod",
+		"env==prod",
+		"env!=prod",
+		" env in (prod, qa) , tier notin (db)",
+		"env=~^(prod|qa),tier",
+		"name=~a{1,2},tier",
+		"_address in (10.0.0.0/8,192.168.1.1)",
+		"_status=BOUND",
+		"_bound>1h,_released<=2016-01-02T15:04:05Z",
+		"!_released",
+	} {
+		_, err := ParseSelector(expr)
+		assert.NoError(err, expr)
+	}
+
+	for _, expr := range []string{
+		",",
+		"env=prod,",
+		"env prod",
+		"env in prod",
+		"env in (prod",
+		"env=~a(b",
+		"env<1h",
+		"_bound=1h",
+		"_bound>yesterday",
+		"_status=taken",
+		"_address in (foo)",
+	} {
+		_, err := ParseSelector(expr)
+		assert.Error(err, expr)
+	}
+}
+
+func TestSelectorMatch(t *testing.T) {
+	assert := assert.New(t)
+
+	network := &api.Network{
+		ID:          "net1",
+		Cidr:        "10.0.0.0/16",
+		Annotations: map[string]string{"env": "prod", "tier": "web"},
+	}
+	pool := &api.Pool{
+		ID:          &api.Pool_PoolID{NetworkID: "net1", ID: "pool1"},
+		Type:        api.Pool_DYNAMIC,
+		Annotations: map[string]string{"env": "qa"},
+	}
+	now := time.Now()
+	binding := &api.Binding{
+		PoolID:       &api.Pool_PoolID{NetworkID: "net1", ID: "pool1"},
+		ID:           "binding1",
+		Address:      "10.0.1.5",
+		AllocateTime: now.Add(-2 * time.Hour).UnixNano(),
+		BindTime:     now.Add(-time.Hour).UnixNano(),
+		Annotations:  map[string]string{"env": "prod"},
+	}
+
+	match := func(expr string) []bool {
+		sel, err := ParseSelector(expr)
+		assert.NoError(err, expr)
+		return []bool{sel.MatchNetwork(network), sel.MatchPool(pool), sel.MatchBinding(binding)}
+	}
+
+	assert.Equal([]bool{true, true, true}, match(""))
+	assert.Equal([]bool{true, true, true}, match("env"))
+	assert.Equal([]bool{false, false, false}, match("!env"))
+	assert.Equal([]bool{true, false, false}, match("tier"))
+	assert.Equal([]bool{true, false, true}, match("env=prod"))
+	assert.Equal([]bool{false, true, false}, match("env!=prod"))
+	assert.Equal([]bool{true, true, true}, match("env in (prod,qa)"))
+	assert.Equal([]bool{false, true, false}, match("env notin (prod)"))
+	assert.Equal([]bool{false, true, true}, match("tier notin (web)"))
+	assert.Equal([]bool{true, true, false}, match("env=~^(prod|qa)$,_id=~^(net|pool)"))
+	assert.Equal([]bool{false, true, true}, match("_id!~^net"))
+	assert.Equal([]bool{false, true, true}, match("_network=net1,_id,env"))
+	assert.Equal([]bool{false, true, false}, match("_type=DYNAMIC"))
+	assert.Equal([]bool{true, false, false}, match("_cidr in (10.0.0.0/16)"))
+
+	assert.Equal([]bool{false, false, true}, match("_address in (10.0.1.0/24)"))
+	assert.Equal([]bool{false, false, true}, match("_address in (10.0.1.5)"))
+	assert.Equal([]bool{true, true, false}, match("_address notin (10.0.0.0/16)"))
+	assert.Equal([]bool{false, false, true}, match("_status=bound"))
+	assert.Equal([]bool{true, true, false}, match("_status!=bound"))
+
+	assert.Equal([]bool{false, false, true}, match("_bound<30m"))
+	assert.Equal([]bool{false, false, false}, match("_bound<90m"))
+	assert.Equal([]bool{false, false, true}, match("_allocated<=90m,_allocated>3h"))
+	assert.Equal([]bool{false, false, true}, match("_bound>="+now.Add(-24*time.Hour).Format(time.RFC3339)))
+	assert.Equal([]bool{true, true, true}, match("!_released"))
+	assert.Equal([]bool{false, false, false}, match("_released"))
+
+	// legacy filters are regular expressions combined with the selector
+	sel, err := NewSelector("_status=bound", map[string]string{"env": "pro"})
+	assert.NoError(err)
+	assert.True(sel.MatchBinding(binding))
+	assert.False(sel.MatchNetwork(network))
+
+	var none *Selector
+	assert.True(none.MatchPool(pool))
+}
+
+func TestBindingsSelector(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+	network, err := config.NewNetwork(map[string]string{}, "10.1.0.0/16")
+	assert.NoError(err)
+	pool, err := network.NewPool(map[string]string{}, 4, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	bound, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	released, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.NoError(pool.Release(released, false))
+
+	sel, err := ParseSelector("_status=bound")
+	assert.NoError(err)
+	bindings, err := network.Bindings(sel)
+	assert.NoError(err)
+	if assert.Len(bindings, 1) {
+		assert.Equal(bound.ID, bindings[0].ID)
+	}
+
+	sel, err = ParseSelector("_released,_address in (10.1.0.0/16)")
+	assert.NoError(err)
+	bindings, err = network.Bindings(sel)
+	assert.NoError(err)
+	if assert.Len(bindings, 1) {
+		assert.Equal(released.ID, bindings[0].ID)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"bytes"
+	"math/rand"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/jive/postal/api"
+)
+
+// orderBindings sorts released bindings into the order BindAny tries to reuse
+// them in under strategy. STICKY pools try the binding last held by affinityKey
+// first, the rest of the bindings are in LOWEST order.
+func orderBindings(strategy api.Pool_Strategy, bindings []*etcdBinding, affinityKey string) {
+	sort.Sort(byAddress(bindings))
+
+	switch strategy {
+	case api.Pool_RANDOM:
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		for i := len(bindings) - 1; i > 0; i-- {
+			j := r.Intn(i + 1)
+			bindings[i], bindings[j] = bindings[j], bindings[i]
+		}
+	case api.Pool_LEAST_RECENTLY_RELEASED:
+		sort.Stable(byReleaseTime(bindings))
+	case api.Pool_STICKY:
+		if affinityKey == "" {
+			return
+		}
+		sticky := -1
+		for idx, binding := range bindings {
+			if binding.AffinityKey != affinityKey {
+				continue
+			}
+			if sticky < 0 || binding.ReleaseTime > bindings[sticky].ReleaseTime {
+				sticky = idx
+			}
+		}
+		if sticky > 0 {
+			binding := bindings[sticky]
+			copy(bindings[1:sticky+1], bindings[:sticky])
+			bindings[0] = binding
+		}
+	}
+}
+
+type byAddress []*etcdBinding
+
+func (b byAddress) Len() int      { return len(b) }
+func (b byAddress) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byAddress) Less(i, j int) bool {
+	return bytes.Compare(net.ParseIP(b[i].Address).To16(), net.ParseIP(b[j].Address).To16()) < 0
+}
+
+type byReleaseTime []*etcdBinding
+
+func (b byReleaseTime) Len() int           { return len(b) }
+func (b byReleaseTime) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byReleaseTime) Less(i, j int) bool { return b[i].ReleaseTime < b[j].ReleaseTime }
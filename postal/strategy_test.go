@@ -0,0 +1,93 @@
+package postal
+
+import (
+	"net"
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func strategyBindings() []*etcdBinding {
+	return []*etcdBinding{
+		{Binding: &api.Binding{Address: "10.0.0.10", ReleaseTime: 30, AffinityKey: "a"}},
+		{Binding: &api.Binding{Address: "10.0.0.9", ReleaseTime: 10, AffinityKey: "b"}},
+		{Binding: &api.Binding{Address: "10.0.0.2", ReleaseTime: 20, AffinityKey: "a"}},
+		{Binding: &api.Binding{Address: "10.0.0.5"}},
+	}
+}
+
+func addresses(bindings []*etcdBinding) []string {
+	addrs := []string{}
+	for _, binding := range bindings {
+		addrs = append(addrs, binding.Address)
+	}
+	return addrs
+}
+
+func TestOrderBindings(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		strategy    api.Pool_Strategy
+		affinityKey string
+		expected    []string
+	}{
+		{api.Pool_LOWEST, "", []string{"10.0.0.2", "10.0.0.5", "10.0.0.9", "10.0.0.10"}},
+		{api.Pool_LEAST_RECENTLY_RELEASED, "", []string{"10.0.0.5", "10.0.0.9", "10.0.0.2", "10.0.0.10"}},
+		{api.Pool_STICKY, "a", []string{"10.0.0.10", "10.0.0.2", "10.0.0.5", "10.0.0.9"}},
+		{api.Pool_STICKY, "b", []string{"10.0.0.9", "10.0.0.2", "10.0.0.5", "10.0.0.10"}},
+		{api.Pool_STICKY, "c", []string{"10.0.0.2", "10.0.0.5", "10.0.0.9", "10.0.0.10"}},
+		{api.Pool_STICKY, "", []string{"10.0.0.2", "10.0.0.5", "10.0.0.9", "10.0.0.10"}},
+	}
+	for _, test := range tests {
+		bindings := strategyBindings()
+		orderBindings(test.strategy, bindings, test.affinityKey)
+		assert.Equal(test.expected, addresses(bindings), "%s %s", test.strategy, test.affinityKey)
+	}
+
+	bindings := strategyBindings()
+	orderBindings(api.Pool_RANDOM, bindings, "")
+	orderBindings(api.Pool_LOWEST, bindings, "")
+	assert.Equal([]string{"10.0.0.2", "10.0.0.5", "10.0.0.9", "10.0.0.10"}, addresses(bindings))
+}
+
+func TestBindAnySticky(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	nm, err := (&Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+
+	pool, err := nm.NewPool(nil, 3, api.Pool_FIXED, WithStrategy(api.Pool_STICKY))
+	assert.NoError(err)
+	assert.Equal(api.Pool_STICKY, pool.APIPool().Strategy)
+	for _, addr := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"} {
+		_, err = pool.Allocate(net.ParseIP(addr))
+		assert.NoError(err)
+	}
+
+	first, err := pool.BindAny(nil, NoTTL, WithAffinityKey("host-1"))
+	assert.NoError(err)
+	assert.Equal("10.0.0.1", first.Address)
+	assert.Equal("host-1", first.AffinityKey)
+
+	second, err := pool.BindAny(nil, NoTTL, WithAffinityKey("host-2"))
+	assert.NoError(err)
+	assert.Equal("10.0.0.2", second.Address)
+
+	// host-2 gets its address back once it is free
+	assert.NoError(pool.Release(second, false))
+	assert.NoError(pool.Release(first, false))
+	binding, err := pool.BindAny(nil, NoTTL, WithAffinityKey("host-2"))
+	assert.NoError(err)
+	assert.Equal("10.0.0.2", binding.Address)
+
+	// keys without a free address fall back to the lowest one
+	binding, err = pool.BindAny(nil, NoTTL, WithAffinityKey("host-3"))
+	assert.NoError(err)
+	assert.Equal("10.0.0.1", binding.Address)
+	assert.Equal("host-3", binding.AffinityKey)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+)
+
+// PoolUsage counts the bindings of a pool.
+type PoolUsage struct {
+	Pool *api.Pool
+	// Bound is the number of bound bindings.
+	Bound uint64
+	// Available is the number of allocated bindings which are not bound.
+	Available uint64
+}
+
+// Allocated returns the number of addresses the pool holds.
+func (u *PoolUsage) Allocated() uint64 {
+	return u.Bound + u.Available
+}
+
+// NetworkUsage reports how many addresses of a network are in use.
+type NetworkUsage struct {
+	Network *api.Network
+	// Size is the number of addresses in the network, capped at math.MaxUint64.
+	// It is zero for networks without ipam.
+	Size uint64
+	// Allocated is the number of addresses taken from the network, including the
+	// reserved network and broadcast addresses.
+	Allocated uint64
+	Pools     []*PoolUsage
+}
+
+// Usage returns the usage of every network. Networks removed while it runs are
+// left out.
+func (config *Config) Usage() ([]*NetworkUsage, error) {
+	networks, err := config.Networks(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "list networks failed")
+	}
+
+	usage := []*NetworkUsage{}
+	for _, network := range networks {
+		nm, err := config.Network(network.ID)
+		if ErrorKindOf(err) == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get network %s", network.ID)
+		}
+
+		u, err := nm.Usage()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get usage of network %s", network.ID)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, nil
+}
+
+func (nm *etcdNetworkManager) Usage() (*NetworkUsage, error) {
+	pools, err := nm.Pools(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "list pools failed")
+	}
+
+	bindings, err := nm.Bindings(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "list bindings failed")
+	}
+
+	usage := &NetworkUsage{
+		Network: nm.APINetwork(),
+		Pools:   make([]*PoolUsage, 0, len(pools)),
+	}
+
+	poolUsage := map[string]*PoolUsage{}
+	for _, pool := range pools {
+		u := &PoolUsage{Pool: pool}
+		poolUsage[pool.ID.ID] = u
+		usage.Pools = append(usage.Pools, u)
+	}
+
+	for _, binding := range bindings {
+		u, ok := poolUsage[binding.PoolID.ID]
+		if !ok {
+			continue
+		}
+		if binding.BindTime > binding.ReleaseTime {
+			u.Bound++
+		} else {
+			u.Available++
+		}
+	}
+
+	// networks without ipam only hold the addresses of their bindings
+	if nm.ipam == nil {
+		usage.Allocated = uint64(len(bindings))
+		return usage, nil
+	}
+
+	usage.Size = nm.ipam.Size()
+	usage.Allocated, err = nm.ipam.Allocated()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count allocated addresses")
+	}
+
+	return usage, nil
+}
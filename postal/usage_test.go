@@ -0,0 +1,62 @@
+package postal
+
+import (
+	"net"
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsage(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+
+	network, err := config.NewNetwork(map[string]string{}, "10.0.0.0/24")
+	assert.NoError(err)
+
+	dynamic, err := network.NewPool(map[string]string{}, 10, api.Pool_DYNAMIC)
+	assert.NoError(err)
+	fixed, err := network.NewPool(map[string]string{}, 5, api.Pool_FIXED)
+	assert.NoError(err)
+
+	var binding *api.Binding
+	for i := 0; i < 3; i++ {
+		binding, err = dynamic.BindAny(map[string]string{}, NoTTL)
+		assert.NoError(err)
+	}
+	assert.NoError(dynamic.Release(binding, false))
+
+	_, err = fixed.Allocate(net.ParseIP("10.0.0.100"))
+	assert.NoError(err)
+	_, err = fixed.Allocate(net.ParseIP("10.0.0.101"))
+	assert.NoError(err)
+
+	usage, err := config.Usage()
+	assert.NoError(err)
+	assert.Equal(1, len(usage))
+
+	nu := usage[0]
+	assert.Equal(network.APINetwork().ID, nu.Network.ID)
+	assert.Equal(uint64(256), nu.Size)
+	// five bindings plus the reserved network and broadcast addresses
+	assert.Equal(uint64(7), nu.Allocated)
+
+	pools := map[string]*PoolUsage{}
+	for _, pu := range nu.Pools {
+		pools[pu.Pool.ID.ID] = pu
+	}
+	assert.Equal(2, len(pools))
+
+	assert.Equal(uint64(2), pools[dynamic.ID()].Bound)
+	assert.Equal(uint64(1), pools[dynamic.ID()].Available)
+	assert.Equal(uint64(3), pools[dynamic.ID()].Allocated())
+	assert.Equal(uint64(10), pools[dynamic.ID()].Pool.MaximumAddresses)
+
+	assert.Equal(uint64(0), pools[fixed.ID()].Bound)
+	assert.Equal(uint64(2), pools[fixed.ID()].Available)
+}
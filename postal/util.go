@@ -19,8 +19,10 @@ package postal
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"path"
 
+	"github.com/jive/postal/ipam"
 	"github.com/twinj/uuid"
 )
 
@@ -32,6 +34,11 @@ func networkMetaKey(ID string) string {
 	return path.Join(networksKey(), ID)
 }
 
+// networkRemovingKey marks a network whose removal is under way.
+func networkRemovingKey(ID string) string {
+	return path.Join(PostalEtcdKeyPrefix, "network", ID, "removing")
+}
+
 func networkPoolsKey(ID string) string {
 	return path.Join(PostalEtcdKeyPrefix, "network", ID, "pools")
 }
@@ -66,6 +73,56 @@ func bindingIDKey(networkID, poolID, bindingID string) string {
 	)
 }
 
+func ownersKey(networkID, poolID string) string {
+	return path.Join(
+		PostalEtcdKeyPrefix,
+		"network", networkID,
+		"pool", poolID,
+		"owners",
+	)
+}
+
+func ownerKey(networkID, poolID, owner string) string {
+	return path.Join(ownersKey(networkID, poolID), url.QueryEscape(owner))
+}
+
+func poolSizeKey(networkID, poolID string) string {
+	return path.Join(
+		PostalEtcdKeyPrefix,
+		"network", networkID,
+		"pool", poolID,
+		"size",
+	)
+}
+
+func bindingLeasesKey() string {
+	return path.Join(PostalEtcdKeyPrefix, "leases")
+}
+
+func bindingLeaseKey(networkID, poolID, bindingID string) string {
+	return path.Join(bindingLeasesKey(), networkID, poolID, bindingID)
+}
+
+func rolesKey() string {
+	return path.Join(PostalEtcdKeyPrefix, "roles")
+}
+
+func roleKey(name string) string {
+	return path.Join(rolesKey(), name)
+}
+
+func ipamKeyPrefix(ID string) string {
+	return path.Join(ipam.IpamEtcdKeyPrefix, ID) + "/"
+}
+
+func historyAddrKey(networkID string, addr net.IP) string {
+	return path.Join(PostalHistoryKeyPrefix, networkID, canonicalIPString(addr))
+}
+
+func historyKey(networkID string, addr net.IP, bindTime int64, bindingID string) string {
+	return path.Join(historyAddrKey(networkID, addr), fmt.Sprintf("%019d", bindTime), bindingID)
+}
+
 func canonicalIPString(addr net.IP) string {
 	ret := ""
 	if addr.To4() != nil {
@@ -88,11 +145,16 @@ func canonicalIPString(addr net.IP) string {
 	return ret[:len(ret)-1]
 }
 
+// mergeMap returns a new map with the entries of base overridden by merge.
 func mergeMap(base, merge map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(merge))
+	for k, v := range base {
+		merged[k] = v
+	}
 	for k, v := range merge {
-		base[k] = v
+		merged[k] = v
 	}
-	return base
+	return merged
 }
 
 func newNetworkID() string {
@@ -0,0 +1,221 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postal
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// Watch streams changes of the networks, pools and bindings matching sel to fn,
+// one response per revision. The watch starts at rev, or if rev is 0 at the current
+// revision, in which case fn first receives a response without events carrying the
+// revision the watch started after. It runs until ctx is done or fn fails.
+func (config *Config) Watch(ctx context.Context, sel *Selector, rev int64, fn func(*api.WatchResponse) error) error {
+	if rev <= 0 {
+		resp, err := config.store.Get(ctx, PostalEtcdKeyPrefix, storage.WithPrefix(), storage.WithLimit(1))
+		if err != nil {
+			return errors.Wrap(err, "etcd kv range failed")
+		}
+
+		err = fn(&api.WatchResponse{Revision: resp.Revision})
+		if err != nil {
+			return err
+		}
+		rev = resp.Revision + 1
+	}
+
+	w := &watcher{
+		sel:     sel,
+		expired: map[string]bool{},
+	}
+
+	wch := config.store.Watch(ctx, PostalEtcdKeyPrefix, storage.WithPrefix(), storage.WithRev(rev))
+	for resp := range wch {
+		if err := resp.Err(); err != nil {
+			return errors.Wrap(err, "etcd watch failed")
+		}
+
+		for len(resp.Events) > 0 {
+			// a single response may hold several revisions
+			n := 1
+			for n < len(resp.Events) && resp.Events[n].Kv.ModRevision == resp.Events[0].Kv.ModRevision {
+				n++
+			}
+
+			events, err := w.revision(resp.Events[:n])
+			if err != nil {
+				return err
+			}
+
+			if len(events) > 0 {
+				err = fn(&api.WatchResponse{
+					Events:   events,
+					Revision: resp.Events[0].Kv.ModRevision,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			resp.Events = resp.Events[n:]
+		}
+	}
+
+	return ctx.Err()
+}
+
+// watcher turns store events into api events.
+type watcher struct {
+	sel *Selector
+
+	// expired holds the bindings whose lease expired, their next release is
+	// reported as an expiry.
+	expired map[string]bool
+}
+
+// revision converts the store events of a single revision.
+func (w *watcher) revision(evs []*storage.Event) ([]*api.Event, error) {
+	keys := make([][]string, len(evs))
+	written := map[string]bool{}
+	for idx, ev := range evs {
+		keys[idx] = strings.Split(strings.TrimPrefix(string(ev.Kv.Key), PostalEtcdKeyPrefix), "/")
+		if isBindingKey(keys[idx]) {
+			written[path.Join(keys[idx][1], keys[idx][3], keys[idx][5])] = true
+		}
+	}
+
+	events := []*api.Event{}
+	for idx, ev := range evs {
+		parts := keys[idx]
+
+		var event *api.Event
+		var err error
+		switch {
+		case len(parts) == 2 && parts[0] == "networks":
+			event, err = w.network(parts[1], ev)
+		case len(parts) == 4 && parts[0] == "network" && parts[2] == "pools":
+			event, err = w.pool(parts[1], parts[3], ev)
+		case isBindingKey(parts):
+			event, err = w.binding(parts[1], parts[3], parts[5], ev)
+		case len(parts) == 4 && parts[0] == "leases" && ev.Type == storage.EventTypeDelete:
+			// releasing a binding drops its lease in the same revision, a lease
+			// dropped on its own has expired
+			id := path.Join(parts[1], parts[2], parts[3])
+			if !written[id] {
+				w.expired[id] = true
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if event == nil {
+			continue
+		}
+
+		if w.sel.matchEvent(event) {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (w *watcher) network(ID string, ev *storage.Event) (*api.Event, error) {
+	event := &api.Event{Network: &api.Network{ID: ID}}
+	switch {
+	case ev.Type == storage.EventTypeDelete:
+		event.Type = api.Event_DELETED
+	case ev.Kv.Version == 1:
+		event.Type = api.Event_CREATED
+	default:
+		event.Type = api.Event_UPDATED
+	}
+
+	if ev.Type != storage.EventTypeDelete {
+		err := json.Unmarshal(ev.Kv.Value, event.Network)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal network")
+		}
+	}
+
+	return event, nil
+}
+
+func (w *watcher) pool(networkID, ID string, ev *storage.Event) (*api.Event, error) {
+	event := &api.Event{Pool: &api.Pool{ID: &api.Pool_PoolID{NetworkID: networkID, ID: ID}}}
+	switch {
+	case ev.Type == storage.EventTypeDelete:
+		event.Type = api.Event_DELETED
+	case ev.Kv.Version == 1:
+		event.Type = api.Event_CREATED
+	default:
+		event.Type = api.Event_UPDATED
+	}
+
+	if ev.Type != storage.EventTypeDelete {
+		err := json.Unmarshal(ev.Kv.Value, event.Pool)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal pool")
+		}
+	}
+
+	return event, nil
+}
+
+func (w *watcher) binding(networkID, poolID, ID string, ev *storage.Event) (*api.Event, error) {
+	id := path.Join(networkID, poolID, ID)
+	expired := w.expired[id]
+	delete(w.expired, id)
+
+	event := &api.Event{Binding: &api.Binding{
+		PoolID: &api.Pool_PoolID{NetworkID: networkID, ID: poolID},
+		ID:     ID,
+	}}
+	if ev.Type == storage.EventTypeDelete {
+		event.Type = api.Event_DELETED
+		return event, nil
+	}
+
+	err := json.Unmarshal(ev.Kv.Value, event.Binding)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal binding")
+	}
+
+	switch {
+	case (&etcdBinding{event.Binding, ev.Kv.Version}).isBound():
+		event.Type = api.Event_BOUND
+	case ev.Kv.Version == 1:
+		event.Type = api.Event_CREATED
+	case expired:
+		event.Type = api.Event_EXPIRED
+	default:
+		event.Type = api.Event_RELEASED
+	}
+
+	return event, nil
+}
+
+func isBindingKey(parts []string) bool {
+	return len(parts) == 6 && parts[0] == "network" && parts[2] == "pool" && parts[4] == "bindings"
+}
@@ -0,0 +1,106 @@
+package postal
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+type watchedEvent struct {
+	kind string
+	typ  api.Event_Type
+}
+
+func collectWatch(ctx context.Context, config *Config, sel *Selector, rev int64) (<-chan watchedEvent, <-chan int64) {
+	events := make(chan watchedEvent, 100)
+	start := make(chan int64, 1)
+	go config.Watch(ctx, sel, rev, func(resp *api.WatchResponse) error {
+		if len(resp.Events) == 0 {
+			start <- resp.Revision
+		}
+		for _, ev := range resp.Events {
+			switch {
+			case ev.Network != nil:
+				events <- watchedEvent{"network", ev.Type}
+			case ev.Pool != nil:
+				events <- watchedEvent{"pool", ev.Type}
+			case ev.Binding != nil:
+				events <- watchedEvent{"binding", ev.Type}
+			}
+		}
+		return nil
+	})
+	return events, start
+}
+
+func expectWatch(assert *assert.Assertions, events <-chan watchedEvent, expected ...watchedEvent) {
+	for _, e := range expected {
+		select {
+		case ev := <-events:
+			assert.Equal(e, ev)
+		case <-time.After(10 * time.Second):
+			assert.Fail("timed out waiting for watch event", "%v", e)
+			return
+		}
+	}
+}
+
+func TestWatch(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	config := (&Config{}).WithStore(store)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go config.ExpireBindings(ctx)
+
+	events, start := collectWatch(ctx, config, nil, 0)
+	startRev := <-start
+
+	nm, err := config.NewNetwork(map[string]string{"site": "edge"}, "10.0.0.0/24")
+	assert.NoError(err)
+	expectWatch(assert, events, watchedEvent{"network", api.Event_CREATED})
+
+	pool, err := nm.NewPool(map[string]string{"app": "proxy"}, 5, api.Pool_DYNAMIC)
+	assert.NoError(err)
+	expectWatch(assert, events, watchedEvent{"pool", api.Event_CREATED})
+
+	assert.NoError(pool.SetMaxSize(10))
+	expectWatch(assert, events, watchedEvent{"pool", api.Event_UPDATED})
+
+	allocated, err := pool.Allocate(net.ParseIP("10.0.0.5"))
+	assert.NoError(err)
+	expectWatch(assert, events, watchedEvent{"binding", api.Event_CREATED})
+
+	bound, err := pool.BindAny(nil, NoTTL)
+	assert.NoError(err)
+	assert.Equal(allocated.Address, bound.Address)
+	expectWatch(assert, events, watchedEvent{"binding", api.Event_BOUND})
+
+	assert.NoError(pool.Release(bound, false))
+	expectWatch(assert, events, watchedEvent{"binding", api.Event_RELEASED})
+
+	leased, err := pool.BindAny(nil, 1)
+	assert.NoError(err)
+	expectWatch(assert, events, watchedEvent{"binding", api.Event_BOUND})
+
+	expectWatch(assert, events, watchedEvent{"binding", api.Event_EXPIRED})
+
+	leased, err = pool.Binding(leased.ID)
+	assert.NoError(err)
+	assert.NoError(pool.Release(leased, true))
+	expectWatch(assert, events, watchedEvent{"binding", api.Event_DELETED})
+
+	// resuming replays everything after the start revision
+	replay, _ := collectWatch(ctx, config, filterSelector(t, map[string]string{"_type": "DYNAMIC"}), startRev+1)
+	expectWatch(assert, replay,
+		watchedEvent{"pool", api.Event_CREATED},
+		watchedEvent{"pool", api.Event_UPDATED},
+	)
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// auditedMethods are the methods of the Postal service which change state.
+var auditedMethods = map[string]bool{
+	"NetworkAdd":          true,
+	"NetworkRemove":       true,
+	"PoolAdd":             true,
+	"PoolRemove":          true,
+	"PoolSetMax":          true,
+	"AllocateAddress":     true,
+	"BulkAllocateAddress": true,
+	"BindAddress":         true,
+	"BindAddresses":       true,
+	"ReleaseAddress":      true,
+	"RenewBinding":        true,
+	"RoleSet":             true,
+	"RoleRemove":          true,
+}
+
+// Auditor records an audit entry for every call of a mutating rpc.
+type Auditor struct {
+	config *postal.Config
+}
+
+// NewAuditor returns an Auditor recording entries in store.
+func NewAuditor(store storage.Store) *Auditor {
+	return &Auditor{config: (&postal.Config{}).WithStore(store)}
+}
+
+// UnaryInterceptor records the outcome of mutating rpcs. It must run after
+// the interceptor of the Authenticator to record the caller, and before the
+// one of the Authorizer to record denied calls.
+func (a *Auditor) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := strings.TrimPrefix(info.FullMethod, postalMethodPrefix)
+	if !auditedMethods[method] {
+		return handler(ctx, req)
+	}
+
+	rec := &auditRecorder{}
+	resp, err := handler(context.WithValue(ctx, auditRecorderKey{}, rec), req)
+
+	entry := auditEntry(IdentityFromContext(ctx), method, req, resp, err)
+	entry.Revision, entry.Replayed = rec.revision(), rec.wasReplayed()
+	if aerr := a.config.AppendAudit(entry); aerr != nil {
+		plog.Errorf("failed to record audit entry of %s by %s: %s", method, entry.Identity, aerr)
+	}
+
+	return resp, err
+}
+
+// auditEntry returns the entry of a call of method, resp is only looked at if
+// the call succeeded.
+func auditEntry(id *Identity, method string, req, resp interface{}, err error) *api.AuditEntry {
+	entry := &api.AuditEntry{
+		Time:   time.Now().UnixNano(),
+		Method: method,
+		Code:   grpc.Code(grpcError(err)).String(),
+	}
+	if id != nil {
+		entry.Identity = id.Name
+		entry.Groups = id.Groups
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	if m, ok := req.(proto.Message); ok {
+		entry.Request, _ = (&jsonpb.Marshaler{OrigName: true}).MarshalToString(m)
+	}
+
+	entry.NetworkID, entry.PoolID = requestScope(req)
+	switch r := req.(type) {
+	case *api.AllocateAddressRequest:
+		entry.Addresses = appendAddress(entry.Addresses, r.Address)
+	case *api.BindAddressRequest:
+		entry.Addresses = appendAddress(entry.Addresses, r.Address)
+	case *api.ReleaseAddressRequest:
+		entry.Addresses = appendAddress(entry.Addresses, r.Address)
+	}
+
+	if err != nil {
+		return entry
+	}
+
+	var bindings []*api.Binding
+	switch r := resp.(type) {
+	case *api.NetworkAddResponse:
+		entry.NetworkID = r.Network.ID
+	case *api.PoolAddResponse:
+		entry.PoolID = r.Pool.ID.ID
+	case *api.AllocateAddressResponse:
+		bindings = []*api.Binding{r.Binding}
+	case *api.BulkAllocateAddressResponse:
+		bindings = r.Bindings
+	case *api.BindAddressResponse:
+		bindings = []*api.Binding{r.Binding}
+	case *api.BindAddressesResponse:
+		bindings = r.Bindings
+	case *api.ReleaseAddressResponse:
+		bindings = []*api.Binding{r.Binding}
+	case *api.RenewBindingResponse:
+		bindings = []*api.Binding{r.Binding}
+	}
+
+	for _, b := range bindings {
+		if b == nil {
+			continue
+		}
+		// releases by address may leave out the pool
+		if len(entry.PoolID) == 0 && b.PoolID != nil {
+			entry.PoolID = b.PoolID.ID
+		}
+		entry.Addresses = appendAddress(entry.Addresses, b.Address)
+	}
+
+	return entry
+}
+
+type auditRecorderKey struct{}
+
+// auditRecorder keeps the store revision of the last change of an rpc, and
+// whether its response was replayed instead.
+type auditRecorder struct {
+	mu       sync.Mutex
+	rev      int64
+	replayed bool
+}
+
+func auditRecorderFromContext(ctx context.Context) *auditRecorder {
+	rec, _ := ctx.Value(auditRecorderKey{}).(*auditRecorder)
+	return rec
+}
+
+func (r *auditRecorder) record(rev int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if rev > r.rev {
+		r.rev = rev
+	}
+}
+
+// revision returns the revision of the last change, 0 if there was none.
+func (r *auditRecorder) revision() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rev
+}
+
+func (r *auditRecorder) markReplayed() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.replayed = true
+}
+
+func (r *auditRecorder) wasReplayed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.replayed
+}
+
+// recordingStore records the revisions of the changes made through it.
+type recordingStore struct {
+	storage.Store
+	rec *auditRecorder
+}
+
+func (s *recordingStore) Put(ctx context.Context, key, val string, opts ...storage.OpOption) (*storage.PutResponse, error) {
+	resp, err := s.Store.Put(ctx, key, val, opts...)
+	if err == nil {
+		s.rec.record(resp.Revision)
+	}
+	return resp, err
+}
+
+func (s *recordingStore) Delete(ctx context.Context, key string, opts ...storage.OpOption) (*storage.DeleteResponse, error) {
+	resp, err := s.Store.Delete(ctx, key, opts...)
+	if err == nil && resp.Deleted > 0 {
+		s.rec.record(resp.Revision)
+	}
+	return resp, err
+}
+
+func (s *recordingStore) Txn(ctx context.Context) storage.Txn {
+	return &recordingTxn{txn: s.Store.Txn(ctx), rec: s.rec}
+}
+
+// recordingTxn records the revision of the transaction if it succeeds.
+type recordingTxn struct {
+	txn storage.Txn
+	rec *auditRecorder
+}
+
+func (t *recordingTxn) If(cmps ...storage.Cmp) storage.Txn {
+	t.txn = t.txn.If(cmps...)
+	return t
+}
+
+func (t *recordingTxn) Then(ops ...storage.Op) storage.Txn {
+	t.txn = t.txn.Then(ops...)
+	return t
+}
+
+func (t *recordingTxn) Commit() (*storage.TxnResponse, error) {
+	resp, err := t.txn.Commit()
+	if err == nil && resp.Succeeded {
+		t.rec.record(resp.Revision)
+	}
+	return resp, err
+}
+
+func appendAddress(addrs []string, addr string) []string {
+	if len(addr) == 0 || contains(addrs, addr) {
+		return addrs
+	}
+	return append(addrs, addr)
+}
+
+// PruneAudit deletes the audit entries older than retention every interval,
+// until ctx is done.
+func (srv *PostalServer) PruneAudit(ctx context.Context, retention, interval time.Duration) {
+	for {
+		deleted, err := srv.config(ctx).PruneAudit(time.Now().Add(-retention).UnixNano())
+		if err != nil {
+			plog.Errorf("failed to prune audit log: %s", err)
+		} else if deleted > 0 {
+			plog.Infof("pruned %d audit entries older than %s", deleted, retention)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestAuditor(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	srv := &PostalServer{store: store}
+	auditor := NewAuditor(store)
+	ctx := withIdentity(context.Background(), &Identity{Name: "alice", Groups: []string{"ops"}})
+
+	call := func(method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+		return auditor.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: postalMethodPrefix + method}, handler)
+	}
+
+	resp, err := call("NetworkAdd", &api.NetworkAddRequest{Cidr: "10.0.0.0/24"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.NetworkAdd(ctx, req.(*api.NetworkAddRequest))
+	})
+	assert.NoError(err)
+	network := resp.(*api.NetworkAddResponse).Network
+
+	resp, err = call("PoolAdd", &api.PoolAddRequest{NetworkID: network.ID, Maximum: 5, Type: api.Pool_DYNAMIC}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.PoolAdd(ctx, req.(*api.PoolAddRequest))
+	})
+	assert.NoError(err)
+	pool := resp.(*api.PoolAddResponse).Pool
+
+	resp, err = call("BindAddress", &api.BindAddressRequest{PoolID: pool.ID}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.BindAddress(ctx, req.(*api.BindAddressRequest))
+	})
+	assert.NoError(err)
+	binding := resp.(*api.BindAddressResponse).Binding
+
+	// the revision of the bind is the one its binding was written at
+	kvs, err := srv.store.Get(context.TODO(), postal.PostalEtcdKeyPrefix, storage.WithPrefix())
+	assert.NoError(err)
+	bindRevision := int64(0)
+	for _, kv := range kvs.Kvs {
+		if strings.HasSuffix(string(kv.Key), "/"+binding.ID) {
+			bindRevision = kv.ModRevision
+		}
+	}
+
+	_, err = call("ReleaseAddress", &api.ReleaseAddressRequest{PoolID: pool.ID, BindingID: binding.ID}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.ReleaseAddress(ctx, req.(*api.ReleaseAddressRequest))
+	})
+	assert.NoError(err)
+
+	_, err = call("ReleaseAddress", &api.ReleaseAddressRequest{PoolID: pool.ID, BindingID: binding.ID}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.ReleaseAddress(ctx, req.(*api.ReleaseAddressRequest))
+	})
+	assert.Error(err)
+
+	// reads are not audited
+	_, err = call("NetworkRange", &api.NetworkRangeRequest{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.NetworkRange(ctx, req.(*api.NetworkRangeRequest))
+	})
+	assert.NoError(err)
+
+	audit, err := srv.AuditRange(context.TODO(), &api.AuditRangeRequest{})
+	assert.NoError(err)
+	if !assert.Equal(5, len(audit.Entries)) {
+		return
+	}
+
+	methods := []string{}
+	for _, entry := range audit.Entries {
+		methods = append(methods, entry.Method)
+		assert.Equal("alice", entry.Identity)
+		assert.Equal([]string{"ops"}, entry.Groups)
+		assert.Equal(network.ID, entry.NetworkID)
+	}
+	assert.Equal([]string{"NetworkAdd", "PoolAdd", "BindAddress", "ReleaseAddress", "ReleaseAddress"}, methods)
+
+	assert.Equal("", audit.Entries[0].PoolID)
+	assert.Equal(pool.ID.ID, audit.Entries[1].PoolID)
+
+	for idx, entry := range audit.Entries[:4] {
+		assert.True(entry.Revision > 0)
+		if idx > 0 {
+			assert.True(entry.Revision > audit.Entries[idx-1].Revision)
+		}
+	}
+	// failed rpcs change nothing
+	assert.Equal(int64(0), audit.Entries[4].Revision)
+
+	bind := audit.Entries[2]
+	assert.Equal(bindRevision, bind.Revision)
+	assert.Equal("OK", bind.Code)
+	assert.Equal([]string{binding.Address}, bind.Addresses)
+	req := map[string]interface{}{}
+	assert.NoError(json.Unmarshal([]byte(bind.Request), &req))
+	assert.Equal(pool.ID.ID, req["poolID"].(map[string]interface{})["ID"])
+
+	// releases by binding ID record the released address
+	assert.Equal([]string{binding.Address}, audit.Entries[3].Addresses)
+
+	assert.Equal("FailedPrecondition", audit.Entries[4].Code)
+	assert.NotEmpty(audit.Entries[4].Error)
+
+	audit, err = srv.AuditRange(context.TODO(), &api.AuditRangeRequest{Address: binding.Address, Method: "BindAddress"})
+	assert.NoError(err)
+	assert.Equal(1, len(audit.Entries))
+}
+
+func TestAuditorMarksReplays(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	srv := &PostalServer{store: store}
+	chain := ChainUnaryInterceptors(NewAuditor(store).UnaryInterceptor, NewIdempotency(store, time.Hour).UnaryInterceptor)
+	ctx := withIdentity(context.Background(), &Identity{Name: "alice"})
+
+	networkResp, err := srv.NetworkAdd(ctx, &api.NetworkAddRequest{Cidr: "10.0.0.0/24"})
+	assert.NoError(err)
+	poolResp, err := srv.PoolAdd(ctx, &api.PoolAddRequest{NetworkID: networkResp.Network.ID, Maximum: 5, Type: api.Pool_DYNAMIC})
+	assert.NoError(err)
+
+	for i := 0; i < 2; i++ {
+		_, err := chain(ctx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID, IdempotencyKey: "retry-1"}, &grpc.UnaryServerInfo{FullMethod: postalMethodPrefix + "BindAddress"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BindAddress(ctx, req.(*api.BindAddressRequest))
+		})
+		assert.NoError(err)
+	}
+
+	audit, err := srv.AuditRange(context.TODO(), &api.AuditRangeRequest{})
+	assert.NoError(err)
+	if !assert.Equal(2, len(audit.Entries)) {
+		return
+	}
+	assert.False(audit.Entries[0].Replayed)
+	assert.True(audit.Entries[0].Revision > 0)
+	assert.True(audit.Entries[1].Replayed)
+	assert.Equal(int64(0), audit.Entries[1].Revision)
+	assert.Equal(audit.Entries[0].Addresses, audit.Entries[1].Addresses)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/csv"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// healthMethodPrefix prefixes the methods of the health service, which load
+// balancers call without credentials.
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// Identity is the authenticated caller of an rpc.
+type Identity struct {
+	// Name is the common name of the client certificate or the name of the token.
+	Name string
+	// Groups are the organizations of the client certificate or the groups of the token.
+	Groups []string
+}
+
+func (id *Identity) String() string {
+	if id == nil {
+		return "anonymous"
+	}
+	return id.Name
+}
+
+type identityKey struct{}
+
+// IdentityFromContext returns the caller of the rpc handled with ctx, or nil
+// if the caller was not authenticated.
+func IdentityFromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+func withIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+type token struct {
+	secret   []byte
+	identity *Identity
+}
+
+// Authenticator identifies the callers of rpcs by a bearer token sent as
+// authorization metadata, or else by the subject of their verified client
+// certificate.
+type Authenticator struct {
+	tokens []token
+}
+
+// NewAuthenticator returns an Authenticator without any tokens, which only
+// accepts callers with a client certificate.
+func NewAuthenticator() *Authenticator {
+	return &Authenticator{}
+}
+
+// AddToken accepts secret as bearer token of id.
+func (a *Authenticator) AddToken(secret string, id *Identity) {
+	a.tokens = append(a.tokens, token{secret: []byte(secret), identity: id})
+}
+
+// LoadTokenFile adds the tokens of a csv file with lines of the form
+// token,name[,group...]. Lines starting with # are ignored.
+func (a *Authenticator) LoadTokenFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open token file")
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	for n := 1; ; n++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read token file %s", path)
+		}
+		if len(record) < 2 || len(record[0]) == 0 || len(record[1]) == 0 {
+			return errors.Errorf("token file %s: token %d is not of the form token,name[,group...]", path, n)
+		}
+
+		a.AddToken(record[0], &Identity{Name: record[1], Groups: record[2:]})
+	}
+}
+
+func (a *Authenticator) authenticate(ctx context.Context) (*Identity, error) {
+	md, _ := metadata.FromContext(ctx)
+	if auth := md["authorization"]; len(auth) > 0 {
+		if !strings.HasPrefix(auth[0], "Bearer ") {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authorization is not a bearer token")
+		}
+		secret := []byte(strings.TrimPrefix(auth[0], "Bearer "))
+		for _, t := range a.tokens {
+			if subtle.ConstantTimeCompare(t.secret, secret) == 1 {
+				return t.identity, nil
+			}
+		}
+		return nil, grpc.Errorf(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 {
+			cert := info.State.VerifiedChains[0][0]
+			return &Identity{Name: cert.Subject.CommonName, Groups: cert.Subject.Organization}, nil
+		}
+	}
+
+	return nil, grpc.Errorf(codes.Unauthenticated, "missing client certificate or bearer token")
+}
+
+// UnaryInterceptor rejects unary rpcs of unknown callers and makes the identity
+// of the caller available through IdentityFromContext.
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+		return handler(ctx, req)
+	}
+
+	id, err := a.authenticate(ctx)
+	if err != nil {
+		plog.Warningf("rejected %s: %s", info.FullMethod, err)
+		return nil, err
+	}
+	return handler(withIdentity(ctx, id), req)
+}
+
+// StreamInterceptor is the UnaryInterceptor of streaming rpcs.
+func (a *Authenticator) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+		return handler(srv, ss)
+	}
+
+	id, err := a.authenticate(ss.Context())
+	if err != nil {
+		plog.Warningf("rejected %s: %s", info.FullMethod, err)
+		return err
+	}
+	return handler(srv, &identityStream{ServerStream: ss, ctx: withIdentity(ss.Context(), id)})
+}
+
+// identityStream overrides the context of a stream with one carrying the identity.
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
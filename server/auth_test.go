@@ -0,0 +1,122 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func bearerContext(token string) context.Context {
+	return metadata.NewContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func certContext(subject pkix.Name) context.Context {
+	cert := &x509.Certificate{Subject: subject}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+			VerifiedChains:   [][]*x509.Certificate{{cert}},
+		}},
+	})
+}
+
+// authenticateUnary runs a unary rpc of method through the authenticator and
+// returns the identity its handler saw.
+func authenticateUnary(a *Authenticator, ctx context.Context, method string) (*Identity, error) {
+	var id *Identity
+	_, err := a.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		id = IdentityFromContext(ctx)
+		return nil, nil
+	})
+	return id, err
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthenticator(t *testing.T) {
+	assert := assert.New(t)
+
+	a := NewAuthenticator()
+	a.AddToken("s3cret", &Identity{Name: "alice", Groups: []string{"ops"}})
+
+	id, err := authenticateUnary(a, bearerContext("s3cret"), "/api.Postal/BindAddress")
+	assert.NoError(err)
+	assert.Equal(&Identity{Name: "alice", Groups: []string{"ops"}}, id)
+
+	_, err = authenticateUnary(a, bearerContext("wrong"), "/api.Postal/BindAddress")
+	assert.Equal(codes.Unauthenticated, grpc.Code(err))
+
+	ctx := metadata.NewContext(context.Background(), metadata.Pairs("authorization", "Basic s3cret"))
+	_, err = authenticateUnary(a, ctx, "/api.Postal/BindAddress")
+	assert.Equal(codes.Unauthenticated, grpc.Code(err))
+
+	_, err = authenticateUnary(a, context.Background(), "/api.Postal/BindAddress")
+	assert.Equal(codes.Unauthenticated, grpc.Code(err))
+
+	// health checks need no credentials
+	id, err = authenticateUnary(a, context.Background(), "/grpc.health.v1.Health/Check")
+	assert.NoError(err)
+	assert.Nil(id)
+	assert.Equal("anonymous", id.String())
+
+	id, err = authenticateUnary(a, certContext(pkix.Name{CommonName: "bob", Organization: []string{"admins"}}), "/api.Postal/NetworkAdd")
+	assert.NoError(err)
+	assert.Equal(&Identity{Name: "bob", Groups: []string{"admins"}}, id)
+
+	// streams see the identity through their context
+	err = a.StreamInterceptor(nil, &contextStream{ctx: bearerContext("s3cret")}, &grpc.StreamServerInfo{FullMethod: "/api.Postal/Watch"}, func(srv interface{}, ss grpc.ServerStream) error {
+		id = IdentityFromContext(ss.Context())
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal("alice", id.Name)
+
+	err = a.StreamInterceptor(nil, &contextStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/api.Postal/Watch"}, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	assert.Equal(codes.Unauthenticated, grpc.Code(err))
+}
+
+func TestLoadTokenFile(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := ioutil.TempFile("", "postal-tokens")
+	assert.NoError(err)
+	defer os.Remove(f.Name())
+	f.WriteString("# token,name,groups\ntok1,alice,ops,dev\ntok2, bob\n")
+	f.Close()
+
+	a := NewAuthenticator()
+	assert.NoError(a.LoadTokenFile(f.Name()))
+
+	id, err := authenticateUnary(a, bearerContext("tok1"), "/api.Postal/BindAddress")
+	assert.NoError(err)
+	assert.Equal(&Identity{Name: "alice", Groups: []string{"ops", "dev"}}, id)
+
+	id, err = authenticateUnary(a, bearerContext("tok2"), "/api.Postal/BindAddress")
+	assert.NoError(err)
+	assert.Equal(&Identity{Name: "bob", Groups: []string{}}, id)
+
+	assert.NoError(ioutil.WriteFile(f.Name(), []byte("tok3\n"), 0600))
+	assert.Error(NewAuthenticator().LoadTokenFile(f.Name()))
+
+	assert.Error(NewAuthenticator().LoadTokenFile(f.Name() + ".missing"))
+}
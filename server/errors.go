@@ -0,0 +1,72 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"github.com/jive/postal/postal"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCodes = map[postal.ErrorKind]codes.Code{
+	postal.ErrNotFound:           codes.NotFound,
+	postal.ErrAlreadyExists:      codes.AlreadyExists,
+	postal.ErrExhausted:          codes.ResourceExhausted,
+	postal.ErrConflict:           codes.Aborted,
+	postal.ErrInvalidArgument:    codes.InvalidArgument,
+	postal.ErrFailedPrecondition: codes.FailedPrecondition,
+}
+
+// grpcError returns err with the status code of its cause. Errors which already
+// carry a code, such as those of the etcd client, keep it.
+func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	cause := errors.Cause(err)
+	code := grpc.Code(cause)
+	switch {
+	case code != codes.Unknown:
+		if cause == err {
+			return err
+		}
+	case cause == context.Canceled:
+		code = codes.Canceled
+	case cause == context.DeadlineExceeded:
+		code = codes.DeadlineExceeded
+	default:
+		if c, ok := errorCodes[postal.ErrorKindOf(cause)]; ok {
+			code = c
+		}
+	}
+
+	return grpc.Errorf(code, "%s", err.Error())
+}
+
+// UnaryErrorInterceptor sets the status code of errors returned by unary rpcs.
+func UnaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, grpcError(err)
+}
+
+// StreamErrorInterceptor sets the status code of errors returned by streaming rpcs.
+func StreamErrorInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return grpcError(handler(srv, ss))
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCError(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(grpcError(nil))
+	assert.Equal(codes.Unknown, grpc.Code(grpcError(errors.New("etcd is down"))))
+	assert.Equal(codes.Canceled, grpc.Code(grpcError(errors.Wrap(context.Canceled, "watch failed"))))
+
+	unavailable := grpc.Errorf(codes.Unavailable, "no leader")
+	assert.Equal(unavailable, grpcError(unavailable))
+	assert.Equal(codes.Unavailable, grpc.Code(grpcError(errors.Wrap(unavailable, "etcd kv get failed"))))
+
+	err := grpcError(errors.Wrap(errors.New("boom"), "failed to create new network"))
+	assert.Equal("failed to create new network: boom", grpc.ErrorDesc(err))
+}
+
+func TestSrvErrorCodes(t *testing.T) {
+	sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		_, err := client.PoolRange(context.TODO(), &api.PoolRangeRequest{ID: &api.Pool_PoolID{NetworkID: "missing"}})
+		assert.Equal(codes.NotFound, grpc.Code(err))
+
+		_, err = client.PoolAdd(context.TODO(), &api.PoolAddRequest{})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		_, err = client.NetworkRange(context.TODO(), &api.NetworkRangeRequest{Selector: "_status=taken"})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		networkResp, err := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{
+			Annotations: map[string]string{},
+			Cidr:        "10.0.0.0/24",
+		})
+		assert.NoError(err)
+
+		poolResp, err := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID:   networkResp.Network.ID,
+			Annotations: map[string]string{},
+			Maximum:     1,
+			Type:        api.Pool_DYNAMIC,
+		})
+		assert.NoError(err)
+
+		_, err = client.BindAddress(context.TODO(), &api.BindAddressRequest{PoolID: poolResp.Pool.ID})
+		assert.NoError(err)
+
+		_, err = client.BindAddress(context.TODO(), &api.BindAddressRequest{PoolID: poolResp.Pool.ID})
+		assert.Equal(codes.ResourceExhausted, grpc.Code(err))
+
+		stream, err := client.Watch(context.TODO(), &api.WatchRequest{Selector: "a in b"})
+		assert.NoError(err)
+		_, err = stream.Recv()
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+	}).execute(t)
+}
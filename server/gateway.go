@@ -0,0 +1,95 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gengo/grpc-gateway/runtime"
+	"github.com/jive/postal/api"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// GatewayOption configures a gateway.
+type GatewayOption func(*gatewayOptions)
+
+type gatewayOptions struct {
+	requireToken bool
+}
+
+// RequireBearerToken makes the gateway reject requests without a bearer token
+// before they reach the grpc server. It is needed whenever the connection of the
+// gateway authenticates on its own, as it does with a client certificate, as
+// requests without a token would otherwise be made as the gateway.
+func RequireBearerToken() GatewayOption {
+	return func(o *gatewayOptions) {
+		o.requireToken = true
+	}
+}
+
+// NewGateway returns a handler which serves the Postal service as HTTP+JSON by
+// calling the grpc server at endpoint, and its OpenAPI spec at /swagger.json.
+// The connection to endpoint is closed once ctx is done.
+func NewGateway(ctx context.Context, endpoint string, opts []grpc.DialOption, gwopts ...GatewayOption) (http.Handler, error) {
+	o := &gatewayOptions{}
+	for _, opt := range gwopts {
+		opt(o)
+	}
+
+	gwmux := runtime.NewServeMux()
+	err := api.RegisterPostalHandlerFromEndpoint(ctx, gwmux, endpoint, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register gateway")
+	}
+
+	var handler http.Handler = gwmux
+	if o.requireToken {
+		handler = requireBearerToken(gwmux)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", handler)
+	mux.HandleFunc("/swagger.json", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, api.SwaggerJSON)
+	})
+
+	return mux, nil
+}
+
+// requireBearerToken answers requests without a bearer token as unauthenticated,
+// in the error format of the gateway.
+func requireBearerToken(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": "missing bearer token",
+				"code":  codes.Unauthenticated,
+			})
+			return
+		}
+		h.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestGateway(t *testing.T) {
+	sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gw, err := NewGateway(ctx, sandboxServerAddr, []grpc.DialOption{grpc.WithInsecure()})
+		assert.NoError(err)
+		ts := httptest.NewServer(gw)
+		defer ts.Close()
+
+		do := func(method, path, body string, out interface{}) int {
+			req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+			assert.NoError(err)
+			resp, err := http.DefaultClient.Do(req)
+			assert.NoError(err)
+			defer resp.Body.Close()
+			if out != nil {
+				assert.NoError(json.NewDecoder(resp.Body).Decode(out))
+			}
+			return resp.StatusCode
+		}
+
+		network := struct {
+			Network struct {
+				ID   string
+				Cidr string
+			}
+		}{}
+		code := do("POST", "/v1/networks", `{"cidr": "10.0.0.0/24", "annotations": {"foo": "bar"}}`, &network)
+		assert.Equal(http.StatusOK, code)
+		assert.Equal("10.0.0.0/24", network.Network.Cidr)
+
+		pool := struct {
+			Pool struct {
+				ID struct{ ID string }
+			}
+		}{}
+		code = do("POST", "/v1/networks/"+network.Network.ID+"/pools", `{"maximum": 2}`, &pool)
+		assert.Equal(http.StatusOK, code)
+
+		binding := struct {
+			Binding struct{ Address string }
+		}{}
+		code = do("POST", "/v1/networks/"+network.Network.ID+"/pools/"+pool.Pool.ID.ID+"/bind", `{}`, &binding)
+		assert.Equal(http.StatusOK, code)
+		assert.NotEmpty(binding.Binding.Address)
+
+		bindings := struct {
+			Bindings []struct{ Address string }
+		}{}
+		code = do("GET", "/v1/networks/"+network.Network.ID+"/bindings?selector=_status%3Dbound", "", &bindings)
+		assert.Equal(http.StatusOK, code)
+		assert.Equal(1, len(bindings.Bindings))
+
+		// status codes of the rpc map to http status codes
+		code = do("DELETE", "/v1/networks/missing", "", nil)
+		assert.Equal(http.StatusNotFound, code)
+		code = do("POST", "/v1/networks/"+network.Network.ID+"/pools/"+pool.Pool.ID.ID+"/bind", `{"address": "10.1.0.1"}`, nil)
+		assert.NotEqual(http.StatusOK, code)
+
+		spec := map[string]interface{}{}
+		code = do("GET", "/swagger.json", "", &spec)
+		assert.Equal(http.StatusOK, code)
+		assert.Contains(spec["paths"], "/v1/networks")
+	}).execute(t)
+}
+
+func TestGatewayRequireBearerToken(t *testing.T) {
+	sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gw, err := NewGateway(ctx, sandboxServerAddr, []grpc.DialOption{grpc.WithInsecure()}, RequireBearerToken())
+		assert.NoError(err)
+		ts := httptest.NewServer(gw)
+		defer ts.Close()
+
+		get := func(path, authorization string) int {
+			req, err := http.NewRequest("GET", ts.URL+path, nil)
+			assert.NoError(err)
+			if authorization != "" {
+				req.Header.Set("Authorization", authorization)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			assert.NoError(err)
+			resp.Body.Close()
+			return resp.StatusCode
+		}
+
+		// requests without a token never reach the server as the gateway
+		assert.Equal(http.StatusUnauthorized, get("/v1/networks", ""))
+		assert.Equal(http.StatusUnauthorized, get("/v1/networks", "Basic Zm9vOmJhcg=="))
+		assert.Equal(http.StatusOK, get("/v1/networks", "Bearer secret"))
+		assert.Equal(http.StatusOK, get("/swagger.json", ""))
+	}).execute(t)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"time"
+
+	"github.com/jive/postal/postal"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthService is the name the postal service reports its health under, health
+// checks of the server as a whole always pass.
+const HealthService = "api.Postal"
+
+// CheckHealth reports whether the store can be read within timeout.
+func (srv *PostalServer) CheckHealth(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := srv.store.Get(ctx, postal.PostalEtcdKeyPrefix)
+	return err
+}
+
+// WatchHealth checks the store every interval and sets the serving status on hs
+// to match, until ctx is done. The service is reported as not serving once it
+// returns.
+func (srv *PostalServer) WatchHealth(ctx context.Context, hs *health.Server, interval time.Duration) {
+	var last healthpb.HealthCheckResponse_ServingStatus = -1
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if err := srv.CheckHealth(ctx, interval); err != nil {
+			if last != healthpb.HealthCheckResponse_NOT_SERVING {
+				plog.Errorf("health check failed, storage unreachable: %s", err)
+			}
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		} else if last == healthpb.HealthCheckResponse_NOT_SERVING {
+			plog.Info("health check passed, storage reachable again")
+		}
+
+		select {
+		case <-ctx.Done():
+			hs.SetServingStatus(HealthService, healthpb.HealthCheckResponse_NOT_SERVING)
+			return
+		default:
+		}
+		hs.SetServingStatus(HealthService, status)
+		last = status
+
+		select {
+		case <-ctx.Done():
+			hs.SetServingStatus(HealthService, healthpb.HealthCheckResponse_NOT_SERVING)
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jive/postal/storage"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(hs *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := hs.Check(context.TODO(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN
+	}
+	return resp.Status
+}
+
+func TestWatchHealth(t *testing.T) {
+	assert := assert.New(t)
+
+	store := storage.NewMemoryStore()
+	srv := NewServer(store)
+	hs := health.NewServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		srv.WatchHealth(ctx, hs, 10*time.Millisecond)
+		close(done)
+	}()
+
+	waitStatus := func(expected healthpb.HealthCheckResponse_ServingStatus) {
+		for i := 0; i < 100 && servingStatus(hs, HealthService) != expected; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.Equal(expected, servingStatus(hs, HealthService))
+	}
+
+	waitStatus(healthpb.HealthCheckResponse_SERVING)
+
+	// an unreachable store fails the health check
+	store.Close()
+	waitStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	cancel()
+	<-done
+	assert.Equal(healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(hs, HealthService))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// IdempotencyMetadataKey is the metadata key of the idempotency key of requests
+// which do not set it themselves.
+const IdempotencyMetadataKey = "idempotency-key"
+
+// idempotentMethods are the methods whose responses are replayed to retries,
+// with the constructors of their responses.
+var idempotentMethods = map[string]func() proto.Message{
+	"AllocateAddress":     func() proto.Message { return &api.AllocateAddressResponse{} },
+	"BulkAllocateAddress": func() proto.Message { return &api.BulkAllocateAddressResponse{} },
+	"BindAddress":         func() proto.Message { return &api.BindAddressResponse{} },
+	"BindAddresses":       func() proto.Message { return &api.BindAddressesResponse{} },
+	"ReleaseAddress":      func() proto.Message { return &api.ReleaseAddressResponse{} },
+}
+
+// Idempotency answers retries of rpcs carrying the same idempotency key with the
+// response of the first call, for as long as the window after it.
+type Idempotency struct {
+	config *postal.Config
+	window time.Duration
+}
+
+// NewIdempotency returns an Idempotency keeping responses in store for window.
+func NewIdempotency(store storage.Store, window time.Duration) *Idempotency {
+	return &Idempotency{config: (&postal.Config{}).WithStore(store), window: window}
+}
+
+// UnaryInterceptor runs calls with a new idempotency key and replays the stored
+// response to later ones. Keys are scoped to the method and the caller, so it
+// must run after the interceptor of the Authenticator. Replays are marked in the
+// entries of the Auditor when it runs before. Failed calls are not stored and may
+// be retried with the same key.
+func (i *Idempotency) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := strings.TrimPrefix(info.FullMethod, postalMethodPrefix)
+	newResponse, ok := idempotentMethods[method]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	key := requestIdempotencyKey(ctx, req)
+	if len(key) == 0 {
+		return handler(ctx, req)
+	}
+
+	data, err := proto.Marshal(req.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	scope := method
+	if id := IdentityFromContext(ctx); id != nil {
+		scope += "/" + id.Name
+	}
+
+	record, reserved, err := i.config.ReserveIdempotency(scope, key, hash, int64(i.window.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	if !reserved {
+		switch {
+		case record.RequestHash != hash:
+			return nil, grpc.Errorf(codes.InvalidArgument, "idempotency key %s was used for a different request", key)
+		case len(record.Response) == 0:
+			return nil, grpc.Errorf(codes.Aborted, "request with idempotency key %s is in progress", key)
+		}
+
+		resp := newResponse()
+		if err := proto.Unmarshal(record.Response, resp); err != nil {
+			return nil, err
+		}
+		idempotentReplays.WithLabelValues(method).Inc()
+		if rec := auditRecorderFromContext(ctx); rec != nil {
+			rec.markReplayed()
+		}
+		return resp, nil
+	}
+
+	// the reservation is short so keys of requests which never complete are freed
+	// soon, it must not lapse while the request runs
+	keepCtx, stopKeeping := context.WithCancel(ctx)
+	kept := make(chan struct{})
+	go func() {
+		defer close(kept)
+		i.config.KeepIdempotency(keepCtx, record)
+	}()
+
+	resp, err := handler(ctx, req)
+	stopKeeping()
+	<-kept
+
+	if err != nil {
+		if cerr := i.config.CancelIdempotency(record); cerr != nil {
+			plog.Errorf("failed to drop idempotency key %s of failed %s: %s", key, method, cerr)
+		}
+		return resp, err
+	}
+
+	data, err = proto.Marshal(resp.(proto.Message))
+	if err == nil {
+		err = i.config.CompleteIdempotency(record, data)
+	}
+	if err != nil {
+		plog.Errorf("failed to store response of %s for idempotency key %s: %s", method, key, err)
+	}
+
+	return resp, nil
+}
+
+// requestIdempotencyKey returns the idempotency key of the request, or of its
+// metadata if the request has none.
+func requestIdempotencyKey(ctx context.Context, req interface{}) string {
+	var key string
+	switch r := req.(type) {
+	case *api.AllocateAddressRequest:
+		key = r.IdempotencyKey
+	case *api.BulkAllocateAddressRequest:
+		key = r.IdempotencyKey
+	case *api.BindAddressRequest:
+		key = r.IdempotencyKey
+	case *api.BindAddressesRequest:
+		key = r.IdempotencyKey
+	case *api.ReleaseAddressRequest:
+		key = r.IdempotencyKey
+	}
+	if len(key) > 0 {
+		return key
+	}
+
+	md, _ := metadata.FromContext(ctx)
+	if keys := md[IdempotencyMetadataKey]; len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
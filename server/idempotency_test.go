@@ -0,0 +1,129 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIdempotency(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	srv := &PostalServer{store: store}
+	idempotency := NewIdempotency(store, time.Hour)
+	ctx := withIdentity(context.Background(), &Identity{Name: "alice"})
+
+	networkResp, err := srv.NetworkAdd(ctx, &api.NetworkAddRequest{Cidr: "10.0.0.0/24"})
+	assert.NoError(err)
+	poolResp, err := srv.PoolAdd(ctx, &api.PoolAddRequest{NetworkID: networkResp.Network.ID, Maximum: 5, Type: api.Pool_DYNAMIC})
+	assert.NoError(err)
+
+	calls := 0
+	bind := func(ctx context.Context, req *api.BindAddressRequest) (*api.BindAddressResponse, error) {
+		resp, err := idempotency.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: postalMethodPrefix + "BindAddress"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return srv.BindAddress(ctx, req.(*api.BindAddressRequest))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*api.BindAddressResponse), nil
+	}
+
+	first, err := bind(ctx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID, IdempotencyKey: "retry-1"})
+	assert.NoError(err)
+
+	// retries get the original binding
+	again, err := bind(ctx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID, IdempotencyKey: "retry-1"})
+	assert.NoError(err)
+	assert.Equal(first.Binding.ID, again.Binding.ID)
+	assert.Equal(1, calls)
+
+	_, err = bind(ctx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID, Address: "10.0.0.9", IdempotencyKey: "retry-1"})
+	assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+	// the key may also be given as metadata
+	mdCtx := metadata.NewContext(ctx, metadata.Pairs(IdempotencyMetadataKey, "retry-2"))
+	second, err := bind(mdCtx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID})
+	assert.NoError(err)
+	assert.NotEqual(first.Binding.Address, second.Binding.Address)
+	again, err = bind(mdCtx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID})
+	assert.NoError(err)
+	assert.Equal(second.Binding.ID, again.Binding.ID)
+	assert.Equal(2, calls)
+
+	// keys are scoped to the caller
+	bobCtx := withIdentity(context.Background(), &Identity{Name: "bob"})
+	third, err := bind(bobCtx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID, IdempotencyKey: "retry-1"})
+	assert.NoError(err)
+	assert.NotEqual(first.Binding.ID, third.Binding.ID)
+
+	// failed calls may be retried with the same key
+	_, err = bind(ctx, &api.BindAddressRequest{PoolID: &api.Pool_PoolID{NetworkID: networkResp.Network.ID, ID: "missing"}, IdempotencyKey: "retry-3"})
+	assert.Error(err)
+	_, err = bind(ctx, &api.BindAddressRequest{PoolID: &api.Pool_PoolID{NetworkID: networkResp.Network.ID, ID: "missing"}, IdempotencyKey: "retry-3"})
+	assert.Error(err)
+	assert.Equal(5, calls)
+
+	// calls without a key always run
+	_, err = bind(ctx, &api.BindAddressRequest{PoolID: poolResp.Pool.ID})
+	assert.NoError(err)
+	assert.Equal(6, calls)
+}
+
+func TestIdempotencySlowCall(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	defer func(ttl int64) { postal.PostalIdempotencyReservationTTL = ttl }(postal.PostalIdempotencyReservationTTL)
+	postal.PostalIdempotencyReservationTTL = 1
+
+	idempotency := NewIdempotency(store, time.Hour)
+	ctx := withIdentity(context.Background(), &Identity{Name: "alice"})
+	info := &grpc.UnaryServerInfo{FullMethod: postalMethodPrefix + "ReleaseAddress"}
+	req := &api.ReleaseAddressRequest{BindingID: "binding", IdempotencyKey: "slow"}
+
+	// retries are turned away while a call runs past the ttl of its reservation
+	running := make(chan struct{})
+	retried := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := idempotency.UnaryInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(running)
+			<-retried
+			return &api.ReleaseAddressResponse{Binding: &api.Binding{ID: "binding"}}, nil
+		})
+		done <- err
+	}()
+
+	<-running
+	time.Sleep(2500 * time.Millisecond)
+	calls := 0
+	_, err := idempotency.UnaryInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &api.ReleaseAddressResponse{}, nil
+	})
+	assert.Equal(codes.Aborted, grpc.Code(err))
+	assert.Equal(0, calls)
+	close(retried)
+	assert.NoError(<-done)
+
+	resp, err := idempotency.UnaryInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &api.ReleaseAddressResponse{}, nil
+	})
+	assert.NoError(err)
+	assert.Equal(0, calls)
+	assert.Equal("binding", resp.(*api.ReleaseAddressResponse).Binding.ID)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jive/postal/postal"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var (
+	rpcsHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "postal",
+		Subsystem: "server",
+		Name:      "handled_rpcs_total",
+		Help:      "Total number of rpcs handled, by method and status code.",
+	}, []string{"method", "code"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "postal",
+		Subsystem: "server",
+		Name:      "rpc_duration_seconds",
+		Help:      "Time taken to handle rpcs, by method.",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+	}, []string{"method"})
+
+	idempotentReplays = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "postal",
+		Subsystem: "server",
+		Name:      "idempotent_replays_total",
+		Help:      "Total number of retried rpcs answered with the original response, by method.",
+	}, []string{"method"})
+)
+
+func init() {
+	prometheus.MustRegister(rpcsHandled)
+	prometheus.MustRegister(rpcDuration)
+	prometheus.MustRegister(idempotentReplays)
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	rpcsHandled.WithLabelValues(method, grpc.Code(err).String()).Inc()
+	rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// UnaryMetricsInterceptor counts and times unary rpcs. It must run outside of
+// UnaryErrorInterceptor to see the status codes sent to clients.
+func UnaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRPC(info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamMetricsInterceptor counts and times streaming rpcs, see UnaryMetricsInterceptor.
+func StreamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observeRPC(info.FullMethod, start, err)
+	return err
+}
+
+var (
+	poolLabels = []string{"network", "pool", "type"}
+
+	poolBoundDesc = prometheus.NewDesc(
+		"postal_pool_bound_addresses",
+		"Number of bound addresses in a pool.",
+		poolLabels, nil)
+	poolAvailableDesc = prometheus.NewDesc(
+		"postal_pool_available_addresses",
+		"Number of allocated addresses in a pool which are not bound.",
+		poolLabels, nil)
+	poolAllocatedDesc = prometheus.NewDesc(
+		"postal_pool_allocated_addresses",
+		"Number of addresses allocated to a pool.",
+		poolLabels, nil)
+	poolMaxDesc = prometheus.NewDesc(
+		"postal_pool_max_addresses",
+		"Maximum number of addresses a pool may hold.",
+		poolLabels, nil)
+
+	networkSizeDesc = prometheus.NewDesc(
+		"postal_network_addresses",
+		"Number of addresses in a network.",
+		[]string{"network", "cidr"}, nil)
+	networkAllocatedDesc = prometheus.NewDesc(
+		"postal_network_allocated_addresses",
+		"Number of addresses allocated from a network, including reserved addresses.",
+		[]string{"network", "cidr"}, nil)
+	networkUtilizationDesc = prometheus.NewDesc(
+		"postal_network_utilization_ratio",
+		"Ratio of allocated to total addresses of a network.",
+		[]string{"network", "cidr"}, nil)
+)
+
+type usageCollector struct {
+	srv *PostalServer
+}
+
+// UsageCollector returns a collector of the address usage of every network and
+// pool. The usage is read from the store on each collection.
+func (srv *PostalServer) UsageCollector() prometheus.Collector {
+	return &usageCollector{srv: srv}
+}
+
+func (c *usageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolBoundDesc
+	ch <- poolAvailableDesc
+	ch <- poolAllocatedDesc
+	ch <- poolMaxDesc
+	ch <- networkSizeDesc
+	ch <- networkAllocatedDesc
+	ch <- networkUtilizationDesc
+}
+
+func (c *usageCollector) Collect(ch chan<- prometheus.Metric) {
+	usage, err := c.srv.config(context.TODO()).Usage()
+	if err != nil {
+		plog.Errorf("failed to collect address usage: %s", err)
+		ch <- prometheus.NewInvalidMetric(networkAllocatedDesc, err)
+		return
+	}
+
+	for _, nu := range usage {
+		collectNetworkUsage(ch, nu)
+	}
+}
+
+func collectNetworkUsage(ch chan<- prometheus.Metric, nu *postal.NetworkUsage) {
+	network, cidr := nu.Network.ID, nu.Network.Cidr
+	ch <- prometheus.MustNewConstMetric(networkAllocatedDesc, prometheus.GaugeValue, float64(nu.Allocated), network, cidr)
+	if nu.Size > 0 {
+		ch <- prometheus.MustNewConstMetric(networkSizeDesc, prometheus.GaugeValue, float64(nu.Size), network, cidr)
+		ch <- prometheus.MustNewConstMetric(networkUtilizationDesc, prometheus.GaugeValue, float64(nu.Allocated)/float64(nu.Size), network, cidr)
+	}
+
+	for _, pu := range nu.Pools {
+		labels := []string{network, pu.Pool.ID.ID, strings.ToLower(pu.Pool.Type.String())}
+		ch <- prometheus.MustNewConstMetric(poolBoundDesc, prometheus.GaugeValue, float64(pu.Bound), labels...)
+		ch <- prometheus.MustNewConstMetric(poolAvailableDesc, prometheus.GaugeValue, float64(pu.Available), labels...)
+		ch <- prometheus.MustNewConstMetric(poolAllocatedDesc, prometheus.GaugeValue, float64(pu.Allocated()), labels...)
+		ch <- prometheus.MustNewConstMetric(poolMaxDesc, prometheus.GaugeValue, float64(pu.Pool.MaximumAddresses), labels...)
+	}
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+// gatherValue returns the value of the counter or gauge name with the given
+// labels, or -1 if there is none.
+func gatherValue(t *testing.T, g prometheus.Gatherer, name string, labels map[string]string) float64 {
+	families, err := g.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	METRICS:
+		for _, m := range family.GetMetric() {
+			for _, pair := range m.GetLabel() {
+				if val, ok := labels[pair.GetName()]; ok && val != pair.GetValue() {
+					continue METRICS
+				}
+			}
+			if m.Counter != nil {
+				return m.GetCounter().GetValue()
+			}
+			return m.GetGauge().GetValue()
+		}
+	}
+	return -1
+}
+
+func TestRPCMetrics(t *testing.T) {
+	sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		method := map[string]string{"method": "/api.Postal/NetworkRange", "code": "NotFound"}
+		before := gatherValue(t, prometheus.DefaultGatherer, "postal_server_handled_rpcs_total", method)
+		if before < 0 {
+			before = 0
+		}
+
+		_, err := client.NetworkRange(context.TODO(), &api.NetworkRangeRequest{ID: "missing"})
+		assert.Error(err)
+
+		// rpcs are counted with the code sent to the client
+		assert.Equal(before+1, gatherValue(t, prometheus.DefaultGatherer, "postal_server_handled_rpcs_total", method))
+	}).execute(t)
+}
+
+func TestUsageCollector(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	srv := NewServer(store)
+	reg := prometheus.NewPedanticRegistry()
+	assert.NoError(reg.Register(srv.UsageCollector()))
+
+	network, err := srv.config(context.TODO()).NewNetwork(map[string]string{}, "10.0.0.0/24")
+	assert.NoError(err)
+	pool, err := network.NewPool(map[string]string{}, 4, api.Pool_DYNAMIC)
+	assert.NoError(err)
+
+	binding, err := pool.BindAny(map[string]string{}, 0)
+	assert.NoError(err)
+	_, err = pool.BindAny(map[string]string{}, 0)
+	assert.NoError(err)
+	assert.NoError(pool.Release(binding, false))
+
+	labels := map[string]string{"network": network.APINetwork().ID, "pool": pool.ID(), "type": "dynamic"}
+	assert.Equal(float64(1), gatherValue(t, reg, "postal_pool_bound_addresses", labels))
+	assert.Equal(float64(1), gatherValue(t, reg, "postal_pool_available_addresses", labels))
+	assert.Equal(float64(2), gatherValue(t, reg, "postal_pool_allocated_addresses", labels))
+	assert.Equal(float64(4), gatherValue(t, reg, "postal_pool_max_addresses", labels))
+
+	labels = map[string]string{"network": network.APINetwork().ID, "cidr": "10.0.0.0/24"}
+	assert.Equal(float64(256), gatherValue(t, reg, "postal_network_addresses", labels))
+	assert.Equal(float64(4), gatherValue(t, reg, "postal_network_allocated_addresses", labels))
+	assert.Equal(float64(4)/256, gatherValue(t, reg, "postal_network_utilization_ratio", labels))
+}
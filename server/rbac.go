@@ -0,0 +1,282 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ghodss/yaml"
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// postalMethodPrefix prefixes the methods of the Postal service, the methods of
+// other services are allowed to every authenticated caller.
+const postalMethodPrefix = "/api.Postal/"
+
+// postalMethods are the names of the rpc methods of the Postal service.
+var postalMethods = func() map[string]bool {
+	methods := map[string]bool{}
+	t := reflect.TypeOf((*api.PostalServer)(nil)).Elem()
+	for i := 0; i < t.NumMethod(); i++ {
+		methods[t.Method(i).Name] = true
+	}
+	return methods
+}()
+
+// Authorizer allows the rpcs granted by the rules of the roles bound to the
+// caller and denies all others.
+//
+// A rule scoped to networks or pools only allows requests targeting one of
+// them, so listing all networks or watching requires a rule without scope.
+type Authorizer struct {
+	roles  []*api.Role
+	config *postal.Config
+
+	// stored caches the stored roles while they are watched
+	mu     sync.RWMutex
+	stored []*api.Role
+	cached bool
+}
+
+// NewAuthorizer returns an Authorizer of the given roles.
+func NewAuthorizer(roles []*api.Role) *Authorizer {
+	return &Authorizer{roles: roles}
+}
+
+// WithStoredRoles makes the authorizer also check the roles stored in store,
+// which are managed with the Role rpcs. They are read on every rpc unless
+// WatchRoles runs.
+func (a *Authorizer) WithStoredRoles(store storage.Store) *Authorizer {
+	a.config = (&postal.Config{}).WithStore(store)
+	return a
+}
+
+// WatchRoles caches the stored roles and keeps them up to date until ctx is done.
+// Should the watch stop, the roles are read on every rpc again until it is restarted.
+func (a *Authorizer) WatchRoles(ctx context.Context) {
+	if a.config == nil {
+		return
+	}
+
+	restartUntilDone(ctx, "stored role watch", func() error {
+		err := a.config.WatchRoles(ctx, func(roles []*api.Role) {
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			a.stored, a.cached = roles, true
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		a.stored, a.cached = nil, false
+		return err
+	})
+}
+
+// storedRoles returns the cached stored roles, or reads them if they are not cached.
+func (a *Authorizer) storedRoles() ([]*api.Role, error) {
+	a.mu.RLock()
+	stored, cached := a.stored, a.cached
+	a.mu.RUnlock()
+	if cached {
+		return stored, nil
+	}
+	return a.config.Roles()
+}
+
+type policyFile struct {
+	Roles []*api.Role `json:"roles"`
+}
+
+// LoadPolicyFile reads the roles of a yaml or json file holding a list of
+// roles under the roles key.
+func LoadPolicyFile(path string) ([]*api.Role, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read policy file")
+	}
+
+	policy := &policyFile{}
+	err = yaml.Unmarshal(data, policy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse policy file %s", path)
+	}
+
+	for _, role := range policy.Roles {
+		err = validateRole(role)
+		if err != nil {
+			return nil, errors.Wrapf(err, "policy file %s", path)
+		}
+	}
+
+	return policy.Roles, nil
+}
+
+// validateRole checks that the rules of role only name methods of the Postal service.
+func validateRole(role *api.Role) error {
+	if len(role.Name) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "role name must be non empty")
+	}
+
+	for _, rule := range role.Rules {
+		for _, method := range rule.Methods {
+			if method != "*" && !postalMethods[method] {
+				return grpc.Errorf(codes.InvalidArgument, "role %s: unknown method %s", role.Name, method)
+			}
+		}
+	}
+	return nil
+}
+
+// Authorize returns a PermissionDenied error unless a role bound to id allows
+// the Postal method with the given name on the network and pool req targets.
+func (a *Authorizer) Authorize(id *Identity, method string, req interface{}) error {
+	if id == nil {
+		return grpc.Errorf(codes.PermissionDenied, "anonymous callers may not call %s", method)
+	}
+
+	roles := a.roles
+	if a.config != nil {
+		stored, err := a.storedRoles()
+		if err != nil {
+			return errors.Wrap(err, "failed to get roles")
+		}
+		roles = append(append([]*api.Role{}, stored...), roles...)
+	}
+
+	networkID, poolID := requestScope(req)
+	for _, role := range roles {
+		if !boundTo(role, id) {
+			continue
+		}
+
+		for _, rule := range role.Rules {
+			if allows(rule, method, networkID, poolID) {
+				return nil
+			}
+		}
+	}
+
+	return grpc.Errorf(codes.PermissionDenied, "%s may not call %s on network %q pool %q", id, method, networkID, poolID)
+}
+
+func boundTo(role *api.Role, id *Identity) bool {
+	if contains(role.Users, id.Name) {
+		return true
+	}
+	for _, group := range id.Groups {
+		if contains(role.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func allows(rule *api.Role_Rule, method, networkID, poolID string) bool {
+	if !contains(rule.Methods, method) && !contains(rule.Methods, "*") {
+		return false
+	}
+	if len(rule.Networks) > 0 && !contains(rule.Networks, networkID) {
+		return false
+	}
+	if len(rule.Pools) > 0 && !contains(rule.Pools, poolID) {
+		return false
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// poolScoped requests target a single pool.
+type poolScoped interface {
+	GetPoolID() *api.Pool_PoolID
+}
+
+// requestScope returns the network and pool req targets, each is empty if the
+// request is not limited to one.
+func requestScope(req interface{}) (networkID, poolID string) {
+	var id *api.Pool_PoolID
+	switch r := req.(type) {
+	case poolScoped:
+		id = r.GetPoolID()
+	case *api.PoolRangeRequest:
+		id = r.ID
+	case *api.PoolRemoveRequest:
+		id = r.ID
+	case *api.PoolAddRequest:
+		return r.NetworkID, ""
+	case *api.NetworkRangeRequest:
+		return r.ID, ""
+	case *api.NetworkRemoveRequest:
+		return r.ID, ""
+	case *api.BindingRangeRequest:
+		return r.NetworkID, ""
+	case *api.AddressHistoryRequest:
+		return r.NetworkID, ""
+	}
+
+	if id == nil {
+		return "", ""
+	}
+	return id.NetworkID, id.ID
+}
+
+// UnaryInterceptor denies unary rpcs the caller is not allowed to make. It
+// must run after the interceptor of the Authenticator.
+func (a *Authorizer) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, postalMethodPrefix) {
+		return handler(ctx, req)
+	}
+
+	err := a.Authorize(IdentityFromContext(ctx), strings.TrimPrefix(info.FullMethod, postalMethodPrefix), req)
+	if err != nil {
+		plog.Warningf("denied %s: %s", info.FullMethod, err)
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor is the UnaryInterceptor of streaming rpcs. Their requests
+// are received after authorization, so rules scoped to networks or pools never
+// allow them.
+func (a *Authorizer) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !strings.HasPrefix(info.FullMethod, postalMethodPrefix) {
+		return handler(srv, ss)
+	}
+
+	err := a.Authorize(IdentityFromContext(ss.Context()), strings.TrimPrefix(info.FullMethod, postalMethodPrefix), nil)
+	if err != nil {
+		plog.Warningf("denied %s: %s", info.FullMethod, err)
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jive/postal/api"
+	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage"
+	"github.com/jive/postal/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var testRoles = []*api.Role{
+	{
+		Name:   "proxy",
+		Groups: []string{"proxies"},
+		Rules: []*api.Role_Rule{
+			{Methods: []string{"BindAddress", "ReleaseAddress"}, Networks: []string{"net1"}, Pools: []string{"poolx"}},
+		},
+	},
+	{
+		Name:  "netops",
+		Users: []string{"alice"},
+		Rules: []*api.Role_Rule{{Methods: []string{"*"}}},
+	},
+	{
+		Name:   "dashboard",
+		Groups: []string{"dashboards"},
+		Rules: []*api.Role_Rule{
+			{Methods: []string{"NetworkRange", "PoolRange", "BindingRange", "Watch"}},
+		},
+	},
+}
+
+func TestAuthorize(t *testing.T) {
+	assert := assert.New(t)
+
+	a := NewAuthorizer(testRoles)
+	proxy := &Identity{Name: "haproxy-1", Groups: []string{"proxies"}}
+	dashboard := &Identity{Name: "grafana", Groups: []string{"dashboards"}}
+	alice := &Identity{Name: "alice"}
+
+	poolx := &api.Pool_PoolID{NetworkID: "net1", ID: "poolx"}
+	pooly := &api.Pool_PoolID{NetworkID: "net1", ID: "pooly"}
+
+	assert.NoError(a.Authorize(proxy, "BindAddress", &api.BindAddressRequest{PoolID: poolx}))
+	assert.NoError(a.Authorize(proxy, "ReleaseAddress", &api.ReleaseAddressRequest{PoolID: poolx}))
+
+	denied := []error{
+		a.Authorize(proxy, "BindAddress", &api.BindAddressRequest{PoolID: pooly}),
+		a.Authorize(proxy, "BindAddress", &api.BindAddressRequest{}),
+		a.Authorize(proxy, "AllocateAddress", &api.AllocateAddressRequest{PoolID: poolx}),
+		a.Authorize(proxy, "BindingRange", &api.BindingRangeRequest{NetworkID: "net1"}),
+		a.Authorize(dashboard, "NetworkAdd", &api.NetworkAddRequest{}),
+		a.Authorize(dashboard, "RoleSet", &api.RoleSetRequest{}),
+		a.Authorize(&Identity{Name: "mallory"}, "NetworkRange", &api.NetworkRangeRequest{}),
+		a.Authorize(nil, "NetworkRange", &api.NetworkRangeRequest{}),
+	}
+	for _, err := range denied {
+		assert.Equal(codes.PermissionDenied, grpc.Code(err), "%v", err)
+	}
+
+	assert.NoError(a.Authorize(dashboard, "NetworkRange", &api.NetworkRangeRequest{}))
+	assert.NoError(a.Authorize(dashboard, "PoolRange", &api.PoolRangeRequest{ID: poolx}))
+	assert.NoError(a.Authorize(dashboard, "Watch", nil))
+
+	assert.NoError(a.Authorize(alice, "NetworkAdd", &api.NetworkAddRequest{}))
+	assert.NoError(a.Authorize(alice, "RoleRemove", &api.RoleRemoveRequest{Name: "proxy"}))
+}
+
+func TestAuthorizeStoredRoles(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	a := NewAuthorizer(nil).WithStoredRoles(store)
+	bob := &Identity{Name: "bob"}
+
+	assert.Equal(codes.PermissionDenied, grpc.Code(a.Authorize(bob, "NetworkAdd", &api.NetworkAddRequest{})))
+
+	config := (&postal.Config{}).WithStore(store)
+	assert.NoError(config.SetRole(&api.Role{
+		Name:  "netops",
+		Users: []string{"bob"},
+		Rules: []*api.Role_Rule{{Methods: []string{"NetworkAdd"}}},
+	}))
+	assert.NoError(a.Authorize(bob, "NetworkAdd", &api.NetworkAddRequest{}))
+
+	assert.NoError(config.RemoveRole("netops"))
+	assert.Equal(codes.PermissionDenied, grpc.Code(a.Authorize(bob, "NetworkAdd", &api.NetworkAddRequest{})))
+}
+
+// getCountingStore counts the reads made through it.
+type getCountingStore struct {
+	storage.Store
+	gets int32
+}
+
+func (s *getCountingStore) Get(ctx context.Context, key string, opts ...storage.OpOption) (*storage.GetResponse, error) {
+	atomic.AddInt32(&s.gets, 1)
+	return s.Store.Get(ctx, key, opts...)
+}
+
+func TestAuthorizerWatchRoles(t *testing.T) {
+	assert := assert.New(t)
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
+
+	counting := &getCountingStore{Store: store}
+	a := NewAuthorizer(nil).WithStoredRoles(counting)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go a.WatchRoles(ctx)
+
+	bob := &Identity{Name: "bob"}
+	allowed := func() bool {
+		return a.Authorize(bob, "NetworkAdd", &api.NetworkAddRequest{}) == nil
+	}
+
+	cached := func() bool {
+		a.mu.RLock()
+		defer a.mu.RUnlock()
+		return a.cached
+	}
+	for i := 0; i < 100 && !cached(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(cached())
+
+	config := (&postal.Config{}).WithStore(store)
+	assert.NoError(config.SetRole(&api.Role{
+		Name:  "netops",
+		Users: []string{"bob"},
+		Rules: []*api.Role_Rule{{Methods: []string{"NetworkAdd"}}},
+	}))
+	for i := 0; i < 100 && !allowed(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(allowed())
+
+	// watched roles are not read again
+	gets := atomic.LoadInt32(&counting.gets)
+	for i := 0; i < 10; i++ {
+		assert.True(allowed())
+	}
+	assert.Equal(gets, atomic.LoadInt32(&counting.gets))
+
+	assert.NoError(config.RemoveRole("netops"))
+	for i := 0; i < 100 && allowed(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.False(allowed())
+}
+
+func TestAuthorizerInterceptors(t *testing.T) {
+	assert := assert.New(t)
+
+	a := NewAuthorizer(testRoles)
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	ctx := withIdentity(context.Background(), &Identity{Name: "grafana", Groups: []string{"dashboards"}})
+	_, err := a.UnaryInterceptor(ctx, &api.NetworkAddRequest{}, &grpc.UnaryServerInfo{FullMethod: "/api.Postal/NetworkAdd"}, handler)
+	assert.Equal(codes.PermissionDenied, grpc.Code(err))
+	assert.False(called)
+
+	_, err = a.UnaryInterceptor(ctx, &api.NetworkRangeRequest{}, &grpc.UnaryServerInfo{FullMethod: "/api.Postal/NetworkRange"}, handler)
+	assert.NoError(err)
+	assert.True(called)
+
+	// other services only need an authenticated caller
+	called = false
+	_, err = a.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	assert.NoError(err)
+	assert.True(called)
+
+	err = a.StreamInterceptor(nil, &contextStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/api.Postal/Watch"}, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	assert.NoError(err)
+
+	err = a.StreamInterceptor(nil, &contextStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/api.Postal/Watch"}, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	assert.Equal(codes.PermissionDenied, grpc.Code(err))
+}
+
+func TestLoadPolicyFile(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := ioutil.TempFile("", "postal-policy")
+	assert.NoError(err)
+	defer os.Remove(f.Name())
+	f.WriteString(`roles:
+- name: proxy
+  groups: [proxies]
+  rules:
+  - methods: [BindAddress, ReleaseAddress]
+    pools: [poolx]
+`)
+	f.Close()
+
+	roles, err := LoadPolicyFile(f.Name())
+	assert.NoError(err)
+	assert.Equal([]*api.Role{{
+		Name:   "proxy",
+		Groups: []string{"proxies"},
+		Rules:  []*api.Role_Rule{{Methods: []string{"BindAddress", "ReleaseAddress"}, Pools: []string{"poolx"}}},
+	}}, roles)
+
+	assert.NoError(ioutil.WriteFile(f.Name(), []byte("roles:\n- name: typo\n  rules:\n  - methods: [Bind]\n"), 0600))
+	_, err = LoadPolicyFile(f.Name())
+	assert.Error(err)
+
+	assert.NoError(ioutil.WriteFile(f.Name(), []byte("roles:\n- users: [alice]\n"), 0600))
+	_, err = LoadPolicyFile(f.Name())
+	assert.Error(err)
+}
+
+func TestSrvRoles(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		role := &api.Role{
+			Name:  "netops",
+			Users: []string{"alice"},
+			Rules: []*api.Role_Rule{{Methods: []string{"NetworkAdd", "NetworkRemove"}}},
+		}
+		setResp, err := client.RoleSet(context.TODO(), &api.RoleSetRequest{Role: role})
+		assert.NoError(err)
+		assert.Equal(role, setResp.Role)
+
+		_, err = client.RoleSet(context.TODO(), &api.RoleSetRequest{Role: &api.Role{
+			Name:  "typo",
+			Rules: []*api.Role_Rule{{Methods: []string{"NetworkCreate"}}},
+		}})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		rangeResp, err := client.RoleRange(context.TODO(), &api.RoleRangeRequest{})
+		assert.NoError(err)
+		assert.Equal([]*api.Role{role}, rangeResp.Roles)
+
+		rangeResp, err = client.RoleRange(context.TODO(), &api.RoleRangeRequest{Name: "netops"})
+		assert.NoError(err)
+		assert.Equal([]*api.Role{role}, rangeResp.Roles)
+
+		_, err = client.RoleRemove(context.TODO(), &api.RoleRemoveRequest{Name: "netops"})
+		assert.NoError(err)
+
+		_, err = client.RoleRange(context.TODO(), &api.RoleRangeRequest{Name: "netops"})
+		assert.Equal(codes.NotFound, grpc.Code(err))
+		_, err = client.RoleRemove(context.TODO(), &api.RoleRemoveRequest{Name: "netops"})
+		assert.Equal(codes.NotFound, grpc.Code(err))
+	})
+	test.execute(t)
+}
@@ -2,27 +2,37 @@ package server
 
 import (
 	"net"
+	"strconv"
+	"time"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/pkg/capnslog"
 	"github.com/jive/postal/api"
 	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 var (
 	plog = capnslog.NewPackageLogger("github.com/jive/postal", "server")
 )
 
+const (
+	// restartBackoffMin is the wait before a background watch is first restarted.
+	restartBackoffMin = time.Second
+	// restartBackoffMax caps the wait between restarts of a background watch.
+	restartBackoffMax = time.Minute
+)
+
 type PostalServer struct {
-	etcd *clientv3.Client
+	store storage.Store
 }
 
-func NewServer(etcd *clientv3.Client) *PostalServer {
+func NewServer(store storage.Store) *PostalServer {
 	return &PostalServer{
-		etcd: etcd,
+		store: store,
 	}
 }
 
@@ -31,18 +41,23 @@ func (srv *PostalServer) Register(s *grpc.Server) {
 	api.RegisterPostalServer(s, srv)
 }
 
-func (srv *PostalServer) config() *postal.Config {
-	return (&postal.Config{}).WithEtcdClient(srv.etcd)
+// config returns the postal config of an rpc, its changes are recorded for the
+// audit log if ctx carries an auditRecorder.
+func (srv *PostalServer) config(ctx context.Context) *postal.Config {
+	if rec := auditRecorderFromContext(ctx); rec != nil {
+		return (&postal.Config{}).WithStore(&recordingStore{Store: srv.store, rec: rec})
+	}
+	return (&postal.Config{}).WithStore(srv.store)
 }
 
 // NetworkRange will return exactly 1 Network for a valid ID.
-// If ID is empty then it will return a list of Network IDs.
+// If ID is empty then it will return a page of Networks.
 func (srv *PostalServer) NetworkRange(ctx context.Context, req *api.NetworkRangeRequest) (*api.NetworkRangeResponse, error) {
 	plog.Infof("rpc: NetworkRange(%s)", req.String())
 	resp := &api.NetworkRangeResponse{}
 
 	if len(req.ID) > 0 {
-		nm, err := srv.config().Network(req.ID)
+		nm, err := srv.config(ctx).Network(req.ID)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.ID)
 		}
@@ -51,20 +66,31 @@ func (srv *PostalServer) NetworkRange(ctx context.Context, req *api.NetworkRange
 		return resp, nil
 	}
 
-	networks, err := srv.config().Networks(req.Filters)
+	page, err := rangePage(req.Size_, req.ContinuationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sel, err := postal.NewSelector(req.Selector, req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	networks, token, err := srv.config(ctx).NetworksPage(sel, page)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve network list")
 	}
 
 	resp.Size_ = int32(len(networks))
 	resp.Networks = networks
+	resp.ContinuationToken = token
 
 	return resp, nil
 }
 
 func (srv *PostalServer) NetworkAdd(ctx context.Context, req *api.NetworkAddRequest) (*api.NetworkAddResponse, error) {
-	plog.Infof("rpc: NetworkAdd(%s)", req)
-	network, err := srv.config().NewNetwork(req.GetAnnotations(), req.Cidr)
+	plog.Infof("rpc: NetworkAdd(%s) by %s", req, IdentityFromContext(ctx))
+	network, err := srv.config(ctx).NewNetwork(req.GetAnnotations(), req.Cidr)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create new network")
 	}
@@ -74,25 +100,48 @@ func (srv *PostalServer) NetworkAdd(ctx context.Context, req *api.NetworkAddRequ
 	}, nil
 }
 
+// NetworkRemove deletes a network with all of its pools and bindings.
+// Unless force is set, a network with bound addresses is not removed.
 func (srv *PostalServer) NetworkRemove(ctx context.Context, req *api.NetworkRemoveRequest) (*api.NetworkRemoveResponse, error) {
-	return nil, errors.New("operation not supported")
+	plog.Infof("rpc: NetworkRemove(%s) by %s", req, IdentityFromContext(ctx))
+	if len(req.ID) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
+	}
+
+	err := srv.config(ctx).RemoveNetwork(req.ID, req.Force)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to remove network (%s)", req.ID)
+	}
+
+	return &api.NetworkRemoveResponse{}, nil
 }
 
 func (srv *PostalServer) PoolRange(ctx context.Context, req *api.PoolRangeRequest) (*api.PoolRangeResponse, error) {
 	plog.Infof("rpc: PoolRange(%s)", req)
+	page, err := rangePage(req.Size_, req.ContinuationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sel, err := postal.NewSelector(req.Selector, req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.ID == nil || req.ID.NetworkID == "" {
-		pools, err := srv.config().Pools(req.Filters)
+		pools, token, err := srv.config(ctx).PoolsPage(sel, page)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to fetch pools")
 		}
 
 		return &api.PoolRangeResponse{
-			Pools: pools,
-			Size_: int32(len(pools)),
+			Pools:             pools,
+			Size_:             int32(len(pools)),
+			ContinuationToken: token,
 		}, nil
 	}
 
-	nm, err := srv.config().Network(req.ID.NetworkID)
+	nm, err := srv.config(ctx).Network(req.ID.NetworkID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.ID.NetworkID)
 	}
@@ -108,31 +157,42 @@ func (srv *PostalServer) PoolRange(ctx context.Context, req *api.PoolRangeReques
 		}, nil
 	}
 
-	pools, err := nm.Pools(req.Filters)
+	pools, token, err := nm.PoolsPage(sel, page)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve pools for network id (%s)", req.ID.NetworkID)
 	}
 
 	resp := &api.PoolRangeResponse{
-		Pools: pools,
-		Size_: int32(len(pools)),
+		Pools:             pools,
+		Size_:             int32(len(pools)),
+		ContinuationToken: token,
 	}
 
 	return resp, nil
 }
 
 func (srv *PostalServer) PoolAdd(ctx context.Context, req *api.PoolAddRequest) (*api.PoolAddResponse, error) {
-	plog.Infof("rpc: PoolAdd(%s)", req)
+	plog.Infof("rpc: PoolAdd(%s) by %s", req, IdentityFromContext(ctx))
 	if len(req.NetworkID) == 0 {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
+	}
+
+	if req.Quarantine < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Quarantine must not be negative")
+	}
+
+	if _, ok := api.Pool_Strategy_name[int32(req.Strategy)]; !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "unknown Strategy %d", req.Strategy)
 	}
 
-	nm, err := srv.config().Network(req.NetworkID)
+	nm, err := srv.config(ctx).Network(req.NetworkID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.NetworkID)
 	}
 
-	pm, err := nm.NewPool(req.Annotations, req.Maximum, req.Type)
+	pm, err := nm.NewPool(req.Annotations, req.Maximum, req.Type,
+		postal.WithQuarantine(req.Quarantine),
+		postal.WithStrategy(req.Strategy))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create new pool")
 	}
@@ -142,21 +202,42 @@ func (srv *PostalServer) PoolAdd(ctx context.Context, req *api.PoolAddRequest) (
 	}, nil
 }
 
+// PoolRemove deletes a pool and releases its addresses back to the network.
+// Unless force is set, a pool with bound addresses is not removed.
 func (srv *PostalServer) PoolRemove(ctx context.Context, req *api.PoolRemoveRequest) (*api.PoolRemoveResponse, error) {
-	return nil, errors.New("operation not supported")
+	plog.Infof("rpc: PoolRemove(%s) by %s", req, IdentityFromContext(ctx))
+	if req.ID == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
+	}
+
+	if len(req.ID.NetworkID) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
+	}
+
+	nm, err := srv.config(ctx).Network(req.ID.NetworkID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.ID.NetworkID)
+	}
+
+	err = nm.RemovePool(req.ID.ID, req.Force)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to remove pool in network (%s) for id (%s)", req.ID.NetworkID, req.ID.ID)
+	}
+
+	return &api.PoolRemoveResponse{}, nil
 }
 
 func (srv *PostalServer) PoolSetMax(ctx context.Context, req *api.PoolSetMaxRequest) (*api.PoolSetMaxResponse, error) {
-	plog.Infof("rpc: PoolSetMax(%s)", req)
+	plog.Infof("rpc: PoolSetMax(%s) by %s", req, IdentityFromContext(ctx))
 	if req.PoolID == nil {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
 	if len(req.PoolID.NetworkID) == 0 {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
-	nm, err := srv.config().Network(req.PoolID.NetworkID)
+	nm, err := srv.config(ctx).Network(req.PoolID.NetworkID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.PoolID.NetworkID)
 	}
@@ -177,36 +258,47 @@ func (srv *PostalServer) PoolSetMax(ctx context.Context, req *api.PoolSetMaxRequ
 func (srv *PostalServer) BindingRange(ctx context.Context, req *api.BindingRangeRequest) (*api.BindingRangeResponse, error) {
 	plog.Infof("rpc: BindingRange(%s)", req)
 	if len(req.NetworkID) == 0 {
-		return nil, errors.New("networkID is not set")
+		return nil, grpc.Errorf(codes.InvalidArgument, "networkID is not set")
 	}
 
-	nm, err := srv.config().Network(req.NetworkID)
+	nm, err := srv.config(ctx).Network(req.NetworkID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get network")
 	}
 
-	bindings, err := nm.Bindings(req.Filters)
+	page, err := rangePage(req.Size_, req.ContinuationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sel, err := postal.NewSelector(req.Selector, req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, token, err := nm.BindingsPage(sel, page)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get bindings")
 	}
 
 	return &api.BindingRangeResponse{
-		Bindings: bindings,
-		Size_:    int32(len(bindings)),
+		Bindings:          bindings,
+		Size_:             int32(len(bindings)),
+		ContinuationToken: token,
 	}, nil
 }
 
 func (srv *PostalServer) AllocateAddress(ctx context.Context, req *api.AllocateAddressRequest) (*api.AllocateAddressResponse, error) {
-	plog.Infof("rpc: AllocateAddress(%s)", req)
+	plog.Infof("rpc: AllocateAddress(%s) by %s", req, IdentityFromContext(ctx))
 	if req.PoolID == nil {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
 	if len(req.PoolID.NetworkID) == 0 {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
-	nm, err := srv.config().Network(req.PoolID.NetworkID)
+	nm, err := srv.config(ctx).Network(req.PoolID.NetworkID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.PoolID.NetworkID)
 	}
@@ -227,21 +319,21 @@ func (srv *PostalServer) AllocateAddress(ctx context.Context, req *api.AllocateA
 }
 
 func (srv *PostalServer) BulkAllocateAddress(ctx context.Context, req *api.BulkAllocateAddressRequest) (*api.BulkAllocateAddressResponse, error) {
-	plog.Infof("rpc: BulkAllocateAddress(%s)", req)
+	plog.Infof("rpc: BulkAllocateAddress(%s) by %s", req, IdentityFromContext(ctx))
 	if req.PoolID == nil {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
 	if len(req.PoolID.NetworkID) == 0 {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
 	ip, ipnet, err := net.ParseCIDR(req.Cidr)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not parse cidr")
+		return nil, grpc.Errorf(codes.InvalidArgument, "could not parse cidr: %v", err)
 	}
 
-	nm, err := srv.config().Network(req.PoolID.NetworkID)
+	nm, err := srv.config(ctx).Network(req.PoolID.NetworkID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.PoolID.NetworkID)
 	}
@@ -269,16 +361,16 @@ func (srv *PostalServer) BulkAllocateAddress(ctx context.Context, req *api.BulkA
 }
 
 func (srv *PostalServer) BindAddress(ctx context.Context, req *api.BindAddressRequest) (*api.BindAddressResponse, error) {
-	plog.Infof("rpc: BindAddress(%s)", req)
+	plog.Infof("rpc: BindAddress(%s) by %s", req, IdentityFromContext(ctx))
 	if req.PoolID == nil {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
 	if len(req.PoolID.NetworkID) == 0 {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
-	nm, err := srv.config().Network(req.PoolID.NetworkID)
+	nm, err := srv.config(ctx).Network(req.PoolID.NetworkID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.PoolID.NetworkID)
 	}
@@ -288,16 +380,21 @@ func (srv *PostalServer) BindAddress(ctx context.Context, req *api.BindAddressRe
 		return nil, errors.Wrapf(err, "failed to retrieve pool in network (%s) for id (%s)", req.PoolID.NetworkID, req.PoolID.ID)
 	}
 
+	opts := []postal.BindOption{postal.WithAffinityKey(req.AffinityKey), postal.WithOwner(req.Owner)}
+	if req.IgnoreQuarantine {
+		opts = append(opts, postal.IgnoreQuarantine())
+	}
+
 	var binding *api.Binding
 	addr := net.ParseIP(req.Address)
 
 	if addr == nil || addr.IsUnspecified() {
-		binding, err = pm.BindAny(req.Annotations)
+		binding, err = pm.BindAny(req.Annotations, req.Ttl, opts...)
 		if err != nil {
 			return nil, errors.Wrap(err, "bind failed")
 		}
 	} else {
-		binding, err = pm.Bind(req.Annotations, addr)
+		binding, err = pm.Bind(req.Annotations, addr, req.Ttl, opts...)
 		if err != nil {
 			return nil, errors.Wrap(err, "bind failed")
 		}
@@ -308,17 +405,97 @@ func (srv *PostalServer) BindAddress(ctx context.Context, req *api.BindAddressRe
 	}, nil
 }
 
+// BindAddresses binds count addresses of a pool in a single transaction. Unless
+// the request is atomic, addresses are bound one at a time if that fails, and
+// the errors of those which could not be bound are returned by their index.
+func (srv *PostalServer) BindAddresses(ctx context.Context, req *api.BindAddressesRequest) (*api.BindAddressesResponse, error) {
+	plog.Infof("rpc: BindAddresses(%s) by %s", req, IdentityFromContext(ctx))
+	if req.PoolID == nil || len(req.PoolID.NetworkID) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
+	}
+
+	if req.Count <= 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Count must be positive")
+	}
+
+	if len(req.BindingAnnotations) > int(req.Count) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "more BindingAnnotations than Count")
+	}
+
+	nm, err := srv.config(ctx).Network(req.PoolID.NetworkID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.PoolID.NetworkID)
+	}
+
+	pm, err := nm.Pool(req.PoolID.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve pool in network (%s) for id (%s)", req.PoolID.NetworkID, req.PoolID.ID)
+	}
+
+	annotations := make([]map[string]string, req.Count)
+	for idx := range annotations {
+		annotations[idx] = map[string]string{}
+		for k, v := range req.Annotations {
+			annotations[idx][k] = v
+		}
+		if idx < len(req.BindingAnnotations) && req.BindingAnnotations[idx] != nil {
+			for k, v := range req.BindingAnnotations[idx].Annotations {
+				annotations[idx][k] = v
+			}
+		}
+	}
+
+	var opts []postal.BindOption
+	if req.IgnoreQuarantine {
+		opts = append(opts, postal.IgnoreQuarantine())
+	}
+
+	bindings, err := pm.BindMany(annotations, req.Ttl, opts...)
+	if err == nil {
+		return &api.BindAddressesResponse{Bindings: bindings}, nil
+	}
+	if req.Atomic {
+		return nil, errors.Wrap(err, "bind failed")
+	}
+	plog.Infof("binding %d addresses together failed, binding them one at a time: %s", req.Count, err)
+
+	resp := &api.BindAddressesResponse{
+		Bindings: []*api.Binding{},
+		Errors:   map[string]*api.Error{},
+	}
+	var exhausted error
+	for idx := range annotations {
+		// once the pool is exhausted, the remaining binds fail as well
+		if exhausted != nil {
+			resp.Errors[strconv.Itoa(idx)] = &api.Error{Message: exhausted.Error()}
+			continue
+		}
+
+		binding, err := pm.BindAny(annotations[idx], req.Ttl, opts...)
+		if err != nil {
+			if postal.ErrorKindOf(err) == postal.ErrExhausted {
+				exhausted = err
+			}
+			resp.Errors[strconv.Itoa(idx)] = &api.Error{Message: err.Error()}
+			continue
+		}
+		resp.Bindings = append(resp.Bindings, binding)
+	}
+
+	return resp, nil
+}
+
 func (srv *PostalServer) ReleaseAddress(ctx context.Context, req *api.ReleaseAddressRequest) (*api.ReleaseAddressResponse, error) {
-	plog.Infof("rpc: ReleaseAddress(%s)", req)
+	plog.Infof("rpc: ReleaseAddress(%s) by %s", req, IdentityFromContext(ctx))
 	if req.PoolID == nil {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
 	if len(req.PoolID.NetworkID) == 0 {
-		return nil, errors.New("NetworkID must be valid")
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
 	}
 
-	nm, err := srv.config().Network(req.PoolID.NetworkID)
+	nm, err := srv.config(ctx).Network(req.PoolID.NetworkID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.PoolID.NetworkID)
 	}
@@ -331,6 +508,10 @@ func (srv *PostalServer) ReleaseAddress(ctx context.Context, req *api.ReleaseAdd
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to find binding for ip (%s)", req.Address)
 		}
+		// a pool given with the address must hold it, authorization is scoped by it
+		if len(req.PoolID.ID) > 0 && binding.PoolID.ID != req.PoolID.ID {
+			return nil, grpc.Errorf(codes.InvalidArgument, "address (%s) is not in pool (%s)", req.Address, req.PoolID.ID)
+		}
 	} else {
 
 		pm, err = nm.Pool(req.PoolID.ID)
@@ -356,7 +537,205 @@ func (srv *PostalServer) ReleaseAddress(ctx context.Context, req *api.ReleaseAdd
 		return nil, errors.Wrap(err, "release binding failed")
 	}
 
-	return &api.ReleaseAddressResponse{}, nil
+	return &api.ReleaseAddressResponse{
+		Binding: binding,
+	}, nil
+}
+
+func (srv *PostalServer) RenewBinding(ctx context.Context, req *api.RenewBindingRequest) (*api.RenewBindingResponse, error) {
+	plog.Infof("rpc: RenewBinding(%s) by %s", req, IdentityFromContext(ctx))
+	if req.PoolID == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
+	}
+
+	if len(req.PoolID.NetworkID) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "NetworkID must be valid")
+	}
+
+	nm, err := srv.config(ctx).Network(req.PoolID.NetworkID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve network for id (%s)", req.PoolID.NetworkID)
+	}
+
+	pm, err := nm.Pool(req.PoolID.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve pool in network (%s) for id (%s)", req.PoolID.NetworkID, req.PoolID.ID)
+	}
+
+	binding, err := pm.Renew(req.BindingID)
+	if err != nil {
+		return nil, errors.Wrap(err, "renew binding failed")
+	}
+
+	return &api.RenewBindingResponse{
+		Binding: binding,
+	}, nil
+}
+
+// AddressHistory returns a page of the bindings an address was bound by.
+func (srv *PostalServer) AddressHistory(ctx context.Context, req *api.AddressHistoryRequest) (*api.AddressHistoryResponse, error) {
+	plog.Infof("rpc: AddressHistory(%s)", req)
+	addr := net.ParseIP(req.Address)
+	if addr == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "address (%s) must be valid", req.Address)
+	}
+
+	page, err := rangePage(req.Size_, req.ContinuationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, token, err := srv.config(ctx).AddressHistoryPage(req.NetworkID, addr, page)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve history of address (%s)", req.Address)
+	}
+
+	return &api.AddressHistoryResponse{
+		Bindings:          bindings,
+		Size_:             int32(len(bindings)),
+		ContinuationToken: token,
+	}, nil
+}
+
+// Watch streams changes of networks, pools and bindings matching the request selector and filters.
+func (srv *PostalServer) Watch(req *api.WatchRequest, stream api.Postal_WatchServer) error {
+	plog.Infof("rpc: Watch(%s)", req)
+	sel, err := postal.NewSelector(req.Selector, req.Filters)
+	if err != nil {
+		return err
+	}
+
+	err = srv.config(stream.Context()).Watch(stream.Context(), sel, req.Revision, stream.Send)
+	if err != nil && stream.Context().Err() == nil {
+		return errors.Wrap(err, "watch failed")
+	}
+	return nil
+}
+
+// RoleRange returns the stored role with the given name, or all stored roles.
+func (srv *PostalServer) RoleRange(ctx context.Context, req *api.RoleRangeRequest) (*api.RoleRangeResponse, error) {
+	plog.Infof("rpc: RoleRange(%s)", req)
+	if len(req.Name) > 0 {
+		role, err := srv.config(ctx).Role(req.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve role (%s)", req.Name)
+		}
+		return &api.RoleRangeResponse{Roles: []*api.Role{role}}, nil
+	}
+
+	roles, err := srv.config(ctx).Roles()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve role list")
+	}
+
+	return &api.RoleRangeResponse{Roles: roles}, nil
+}
+
+// RoleSet stores a role, replacing the stored role of the same name.
+func (srv *PostalServer) RoleSet(ctx context.Context, req *api.RoleSetRequest) (*api.RoleSetResponse, error) {
+	plog.Infof("rpc: RoleSet(%s) by %s", req, IdentityFromContext(ctx))
+	if req.Role == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Role must be set")
+	}
+
+	err := validateRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.config(ctx).SetRole(req.Role)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to set role (%s)", req.Role.Name)
+	}
+
+	return &api.RoleSetResponse{Role: req.Role}, nil
+}
+
+// RoleRemove deletes a stored role.
+func (srv *PostalServer) RoleRemove(ctx context.Context, req *api.RoleRemoveRequest) (*api.RoleRemoveResponse, error) {
+	plog.Infof("rpc: RoleRemove(%s) by %s", req, IdentityFromContext(ctx))
+	err := srv.config(ctx).RemoveRole(req.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to remove role (%s)", req.Name)
+	}
+
+	return &api.RoleRemoveResponse{}, nil
+}
+
+// AuditRange returns a page of the audit entries matching the request filters.
+func (srv *PostalServer) AuditRange(ctx context.Context, req *api.AuditRangeRequest) (*api.AuditRangeResponse, error) {
+	plog.Infof("rpc: AuditRange(%s)", req)
+	page, err := rangePage(req.Size_, req.ContinuationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, token, err := srv.config(ctx).AuditPage(&postal.AuditFilter{
+		Start:     req.Start,
+		End:       req.End,
+		NetworkID: req.NetworkID,
+		PoolID:    req.PoolID,
+		Address:   req.Address,
+		Identity:  req.Identity,
+		Method:    req.Method,
+	}, page)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve audit entries")
+	}
+
+	return &api.AuditRangeResponse{
+		Entries:           entries,
+		Size_:             int32(len(entries)),
+		ContinuationToken: token,
+	}, nil
+}
+
+// ExpireBindings releases leased bindings that are not renewed in time until ctx is done.
+// Expiry stops when the lease watch fails, for instance when it is compacted or the
+// store connection drops. It is then restarted with a backoff, releasing the bindings
+// whose lease expired in the meantime first.
+func (srv *PostalServer) ExpireBindings(ctx context.Context) {
+	restartUntilDone(ctx, "binding lease expiry", func() error {
+		return srv.config(ctx).ExpireBindings(ctx)
+	})
+}
+
+// restartUntilDone runs watch until ctx is done, restarting it with a backoff
+// whenever it stops.
+func restartUntilDone(ctx context.Context, name string, watch func() error) {
+	backoff := restartBackoffMin
+	for {
+		started := time.Now()
+		err := watch()
+		if ctx.Err() != nil {
+			return
+		}
+
+		// the watch ran fine for a while before it stopped, so back off from the start
+		if time.Since(started) > restartBackoffMax {
+			backoff = restartBackoffMin
+		}
+		plog.Errorf("%s stopped, restarting in %s: %v", name, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > restartBackoffMax {
+			backoff = restartBackoffMax
+		}
+	}
+}
+
+// rangePage returns the page a range request asks for.
+func rangePage(size int32, token string) (postal.Page, error) {
+	if size < 0 {
+		return postal.Page{}, grpc.Errorf(codes.InvalidArgument, "size must not be negative")
+	}
+	return postal.Page{Limit: int(size), Token: token}, nil
 }
 
 func inc(ip net.IP) {
@@ -3,42 +3,44 @@ package server
 import (
 	"fmt"
 	"net"
+	"sync/atomic"
 	"testing"
 	"time"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/jive/postal/api"
+	"github.com/jive/postal/postal"
+	"github.com/jive/postal/storage"
+	"github.com/jive/postal/storage/storagetest"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
+// sandboxServerAddr is the address sandboxed servers listen on.
+const sandboxServerAddr = "127.0.0.1:54321"
+
 type sandboxedServerTest func(assert *assert.Assertions, client api.PostalClient)
 
 func (srvTest sandboxedServerTest) execute(t *testing.T) {
 	assert := assert.New(t)
 
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{"127.0.0.1:2379"},
-		DialTimeout: 5 * time.Second,
-	})
-	assert.NoError(err)
-
-	defer cli.Close()
-	defer cli.KV.Delete(context.Background(), "/", clientv3.WithPrefix())
+	store, cleanup := storagetest.NewStore(t)
+	defer cleanup()
 
-	serverAddr := "127.0.0.1:54321"
-
-	lis, err := net.Listen("tcp", serverAddr)
+	lis, err := net.Listen("tcp", sandboxServerAddr)
 	defer lis.Close()
 	assert.NoError(err)
 
-	grpcServer := grpc.NewServer()
-	srv := PostalServer{etcd: cli}
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(ChainUnaryInterceptors(UnaryMetricsInterceptor, UnaryErrorInterceptor)),
+		grpc.StreamInterceptor(ChainStreamInterceptors(StreamMetricsInterceptor, StreamErrorInterceptor)),
+	)
+	srv := PostalServer{store: store}
 	srv.Register(grpcServer)
 	go grpcServer.Serve(lis)
 
-	conn, err := grpc.Dial(serverAddr, grpc.WithInsecure())
+	conn, err := grpc.Dial(sandboxServerAddr, grpc.WithInsecure())
 	assert.NoError(err)
 	defer conn.Close()
 	client := api.NewPostalClient(conn)
@@ -383,8 +385,10 @@ func TestSrvBulkAllocate(t *testing.T) {
 			Cidr:   "10.0.255.0/24",
 		})
 		assert.NoError(allocErr)
-		assert.Equal(0, len(allocResp.GetErrors()))
-		assert.Equal(256, len(allocResp.GetBindings()))
+		// the broadcast address of the network can not be allocated
+		assert.Equal(1, len(allocResp.GetErrors()))
+		assert.Contains(allocResp.GetErrors(), "10.0.255.255")
+		assert.Equal(255, len(allocResp.GetBindings()))
 
 		_, allocErr = client.BulkAllocateAddress(context.TODO(), &api.BulkAllocateAddressRequest{
 			PoolID: poolResp.Pool.ID,
@@ -404,8 +408,10 @@ func TestSrvBulkAllocate(t *testing.T) {
 			Cidr:   "10.0.0.0/26",
 		})
 		assert.NoError(allocErr)
-		assert.Equal(0, len(allocResp.GetErrors()))
-		assert.Equal(64, len(allocResp.GetBindings()))
+		// neither can the network address
+		assert.Equal(1, len(allocResp.GetErrors()))
+		assert.Contains(allocResp.GetErrors(), "10.0.0.0")
+		assert.Equal(63, len(allocResp.GetBindings()))
 
 	})
 
@@ -560,3 +566,417 @@ func TestConcurrentBind(t *testing.T) {
 
 	test.execute(t)
 }
+
+func TestBindAnyDynamic(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		_, networkCidr, _ := net.ParseCIDR("10.0.0.0/16")
+		networkResp, networkErr := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{
+			Annotations: map[string]string{},
+			Cidr:        networkCidr.String(),
+		})
+		assert.NoError(networkErr)
+
+		poolResp, poolErr := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID:   networkResp.Network.ID,
+			Annotations: map[string]string{},
+			Maximum:     10,
+			Type:        api.Pool_DYNAMIC,
+		})
+		assert.NoError(poolErr)
+
+		type bindResult struct {
+			addr string
+			err  error
+		}
+		results := make(chan bindResult)
+
+		// one more than the pool's maximum, so exactly one bind must fail
+		for i := 0; i < 11; i++ {
+			go func() {
+				resp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+					PoolID: poolResp.Pool.ID,
+				})
+				if err != nil {
+					results <- bindResult{err: err}
+					return
+				}
+				results <- bindResult{addr: resp.Binding.Address}
+			}()
+		}
+
+		addresses := map[string]struct{}{}
+		failures := []error{}
+
+		for i := 0; i < 11; i++ {
+			result := <-results
+			if result.err != nil {
+				failures = append(failures, result.err)
+				continue
+			}
+
+			assert.True(networkCidr.Contains(net.ParseIP(result.addr)))
+			if _, ok := addresses[result.addr]; ok {
+				assert.Fail("duplicate address found: " + result.addr)
+			} else {
+				addresses[result.addr] = struct{}{}
+			}
+		}
+
+		assert.Len(addresses, 10)
+		if assert.Len(failures, 1) {
+			assert.Equal(codes.ResourceExhausted, grpc.Code(failures[0]))
+		}
+
+		_, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID: poolResp.Pool.ID,
+		})
+		assert.Equal(codes.ResourceExhausted, grpc.Code(err))
+	})
+
+	test.execute(t)
+}
+
+func TestSrvRemove(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		networkResp, err := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{
+			Cidr: "10.0.0.0/24",
+		})
+		assert.NoError(err)
+		networkID := networkResp.Network.ID
+
+		poolResp, err := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID: networkID,
+			Maximum:   2,
+			Type:      api.Pool_DYNAMIC,
+		})
+		assert.NoError(err)
+		poolID := poolResp.Pool.ID
+
+		bindResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID: poolID,
+		})
+		assert.NoError(err)
+
+		// a pool with bound addresses is only removed with force
+		_, err = client.PoolRemove(context.TODO(), &api.PoolRemoveRequest{ID: poolID})
+		assert.Error(err)
+
+		_, err = client.PoolRemove(context.TODO(), &api.PoolRemoveRequest{ID: poolID, Force: true})
+		assert.NoError(err)
+
+		_, err = client.PoolRange(context.TODO(), &api.PoolRangeRequest{ID: poolID})
+		assert.Error(err)
+
+		// the address went back to the network and can be bound by another pool
+		poolResp, err = client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID: networkID,
+			Maximum:   2,
+			Type:      api.Pool_DYNAMIC,
+		})
+		assert.NoError(err)
+
+		_, err = client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:  poolResp.Pool.ID,
+			Address: bindResp.Binding.Address,
+		})
+		assert.NoError(err)
+
+		_, err = client.NetworkRemove(context.TODO(), &api.NetworkRemoveRequest{ID: networkID})
+		assert.Error(err)
+
+		_, err = client.NetworkRemove(context.TODO(), &api.NetworkRemoveRequest{ID: networkID, Force: true})
+		assert.NoError(err)
+
+		_, err = client.NetworkRange(context.TODO(), &api.NetworkRangeRequest{ID: networkID})
+		assert.Error(err)
+	})
+
+	test.execute(t)
+}
+
+func TestSrvAddressHistory(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		networkResp, err := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{Cidr: "10.0.0.0/24"})
+		assert.NoError(err)
+		poolResp, err := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID: networkResp.Network.ID,
+			Maximum:   5,
+			Type:      api.Pool_DYNAMIC,
+		})
+		assert.NoError(err)
+
+		bindResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:      poolResp.Pool.ID,
+			Address:     "10.0.0.7",
+			Annotations: map[string]string{"host": "proxy-1"},
+		})
+		assert.NoError(err)
+
+		_, err = client.ReleaseAddress(context.TODO(), &api.ReleaseAddressRequest{
+			PoolID:    poolResp.Pool.ID,
+			BindingID: bindResp.Binding.ID,
+			Hard:      true,
+		})
+		assert.NoError(err)
+
+		// the hard released binding is gone, its history remains
+		resp, err := client.AddressHistory(context.TODO(), &api.AddressHistoryRequest{
+			NetworkID: networkResp.Network.ID,
+			Address:   "10.0.0.7",
+		})
+		assert.NoError(err)
+		if assert.Equal(1, len(resp.Bindings)) {
+			assert.Equal(bindResp.Binding.ID, resp.Bindings[0].ID)
+			assert.Equal("proxy-1", resp.Bindings[0].Annotations["host"])
+			assert.True(resp.Bindings[0].ReleaseTime > resp.Bindings[0].BindTime)
+		}
+
+		_, err = client.AddressHistory(context.TODO(), &api.AddressHistoryRequest{
+			NetworkID: networkResp.Network.ID,
+			Address:   "not-an-ip",
+		})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+	})
+	test.execute(t)
+}
+
+func TestSrvQuarantine(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		networkResp, err := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{Cidr: "10.0.0.0/24"})
+		assert.NoError(err)
+
+		_, err = client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID:  networkResp.Network.ID,
+			Maximum:    5,
+			Type:       api.Pool_DYNAMIC,
+			Quarantine: -1,
+		})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		poolResp, err := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID:  networkResp.Network.ID,
+			Maximum:    5,
+			Type:       api.Pool_DYNAMIC,
+			Quarantine: 3600,
+		})
+		assert.NoError(err)
+		assert.Equal(int64(3600), poolResp.Pool.Quarantine)
+
+		bindResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:  poolResp.Pool.ID,
+			Address: "10.0.0.7",
+		})
+		assert.NoError(err)
+
+		releaseResp, err := client.ReleaseAddress(context.TODO(), &api.ReleaseAddressRequest{
+			PoolID:    poolResp.Pool.ID,
+			BindingID: bindResp.Binding.ID,
+		})
+		assert.NoError(err)
+		assert.NotNil(releaseResp)
+
+		_, err = client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:  poolResp.Pool.ID,
+			Address: "10.0.0.7",
+		})
+		assert.Equal(codes.FailedPrecondition, grpc.Code(err))
+
+		rangeResp, err := client.BindingRange(context.TODO(), &api.BindingRangeRequest{
+			NetworkID: networkResp.Network.ID,
+			Selector:  "_status=quarantined",
+		})
+		assert.NoError(err)
+		if assert.Equal(1, len(rangeResp.Bindings)) {
+			assert.True(rangeResp.Bindings[0].QuarantineUntil > time.Now().UnixNano())
+		}
+
+		rebindResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:           poolResp.Pool.ID,
+			Address:          "10.0.0.7",
+			IgnoreQuarantine: true,
+		})
+		assert.NoError(err)
+		assert.Equal(bindResp.Binding.ID, rebindResp.Binding.ID)
+	})
+	test.execute(t)
+}
+
+func TestSrvPoolStrategy(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		networkResp, err := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{Cidr: "10.0.0.0/24"})
+		assert.NoError(err)
+
+		_, err = client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID: networkResp.Network.ID,
+			Maximum:   5,
+			Strategy:  api.Pool_Strategy(42),
+		})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		poolResp, err := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID: networkResp.Network.ID,
+			Maximum:   5,
+			Strategy:  api.Pool_STICKY,
+		})
+		assert.NoError(err)
+		assert.Equal(api.Pool_STICKY, poolResp.Pool.Strategy)
+
+		bindResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:      poolResp.Pool.ID,
+			AffinityKey: "host-1",
+		})
+		assert.NoError(err)
+		assert.Equal("host-1", bindResp.Binding.AffinityKey)
+
+		_, err = client.ReleaseAddress(context.TODO(), &api.ReleaseAddressRequest{
+			PoolID:    poolResp.Pool.ID,
+			BindingID: bindResp.Binding.ID,
+		})
+		assert.NoError(err)
+
+		rebindResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:      poolResp.Pool.ID,
+			AffinityKey: "host-1",
+		})
+		assert.NoError(err)
+		assert.Equal(bindResp.Binding.Address, rebindResp.Binding.Address)
+	})
+	test.execute(t)
+}
+
+func TestSrvBindOwner(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		networkResp, err := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{Cidr: "10.0.0.0/24"})
+		assert.NoError(err)
+		poolResp, err := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID: networkResp.Network.ID,
+			Maximum:   5,
+			Type:      api.Pool_DYNAMIC,
+		})
+		assert.NoError(err)
+
+		bindResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID: poolResp.Pool.ID,
+			Owner:  "proxy-1",
+		})
+		assert.NoError(err)
+
+		againResp, err := client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID: poolResp.Pool.ID,
+			Owner:  "proxy-1",
+		})
+		assert.NoError(err)
+		assert.Equal(bindResp.Binding.ID, againResp.Binding.ID)
+
+		_, err = client.BindAddress(context.TODO(), &api.BindAddressRequest{
+			PoolID:  poolResp.Pool.ID,
+			Address: "10.0.0.99",
+			Owner:   "proxy-1",
+		})
+		assert.Equal(codes.AlreadyExists, grpc.Code(err))
+	})
+	test.execute(t)
+}
+
+func TestSrvBindAddresses(t *testing.T) {
+	test := sandboxedServerTest(func(assert *assert.Assertions, client api.PostalClient) {
+		networkResp, err := client.NetworkAdd(context.TODO(), &api.NetworkAddRequest{Cidr: "10.0.0.0/24"})
+		assert.NoError(err)
+		poolResp, err := client.PoolAdd(context.TODO(), &api.PoolAddRequest{
+			NetworkID: networkResp.Network.ID,
+			Maximum:   4,
+			Type:      api.Pool_DYNAMIC,
+		})
+		assert.NoError(err)
+
+		_, err = client.BindAddresses(context.TODO(), &api.BindAddressesRequest{PoolID: poolResp.Pool.ID})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		resp, err := client.BindAddresses(context.TODO(), &api.BindAddressesRequest{
+			PoolID:      poolResp.Pool.ID,
+			Count:       3,
+			Annotations: map[string]string{"tier": "proxy"},
+			BindingAnnotations: []*api.BindAddressesRequest_Annotations{
+				{Annotations: map[string]string{"host": "proxy-1"}},
+			},
+		})
+		assert.NoError(err)
+		assert.Empty(resp.Errors)
+		if assert.Equal(3, len(resp.Bindings)) {
+			assert.Equal(map[string]string{"tier": "proxy", "host": "proxy-1"}, resp.Bindings[0].Annotations)
+			assert.Equal(map[string]string{"tier": "proxy"}, resp.Bindings[1].Annotations)
+		}
+
+		// atomic binds fail as a whole
+		_, err = client.BindAddresses(context.TODO(), &api.BindAddressesRequest{
+			PoolID: poolResp.Pool.ID,
+			Count:  2,
+			Atomic: true,
+		})
+		assert.Equal(codes.ResourceExhausted, grpc.Code(err))
+
+		// others bind what they can
+		resp, err = client.BindAddresses(context.TODO(), &api.BindAddressesRequest{
+			PoolID: poolResp.Pool.ID,
+			Count:  2,
+		})
+		assert.NoError(err)
+		assert.Equal(1, len(resp.Bindings))
+		if assert.Equal(1, len(resp.Errors)) {
+			assert.NotNil(resp.Errors["1"])
+		}
+	})
+	test.execute(t)
+}
+
+// endingWatchStore ends its first watch right away, the way a compacted watch ends.
+type endingWatchStore struct {
+	storage.Store
+	watches int32
+}
+
+func (s *endingWatchStore) Watch(ctx context.Context, key string, opts ...storage.OpOption) storage.WatchChan {
+	if atomic.AddInt32(&s.watches, 1) == 1 {
+		wch := make(chan storage.WatchResponse)
+		close(wch)
+		return wch
+	}
+	return s.Store.Watch(ctx, key, opts...)
+}
+
+func TestExpireBindingsRestart(t *testing.T) {
+	assert := assert.New(t)
+	store := &endingWatchStore{Store: storage.NewMemoryStore()}
+	srv := NewServer(store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		srv.ExpireBindings(ctx)
+		close(done)
+	}()
+
+	nm, err := (&postal.Config{}).WithStore(store).NewNetwork(nil, "10.0.0.0/24")
+	assert.NoError(err)
+	pool, err := nm.NewPool(nil, 2, api.Pool_DYNAMIC)
+	assert.NoError(err)
+	leased, err := pool.BindAny(nil, 1)
+	assert.NoError(err)
+
+	// the lease expires while expiry restarts, it is released all the same
+	binding := leased
+	for i := 0; i < 50 && binding.BindTime > binding.ReleaseTime; i++ {
+		time.Sleep(100 * time.Millisecond)
+		binding, err = pool.Binding(leased.ID)
+		assert.NoError(err)
+	}
+	assert.True(binding.ReleaseTime > binding.BindTime)
+	assert.True(atomic.LoadInt32(&store.watches) > 1)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail("lease expiry did not stop with its context")
+	}
+}
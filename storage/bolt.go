@@ -0,0 +1,185 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+)
+
+var (
+	boltKVBucket    = []byte("kv")
+	boltLeaseBucket = []byte("lease")
+	boltMetaBucket  = []byte("meta")
+
+	boltRevKey   = []byte("rev")
+	boltLeaseKey = []byte("lease")
+)
+
+// boltLease is the persisted form of a lease. The expiry is absolute so
+// leases keep running down while the store is closed.
+type boltLease struct {
+	TTL    time.Duration `json:"ttl"`
+	Expiry time.Time     `json:"expiry"`
+}
+
+type boltBackend struct {
+	db *bolt.DB
+}
+
+// NewBoltStore returns a Store which keeps its state in a bolt database at path,
+// for single node deployments without an etcd cluster. The whole key space is
+// also held in memory and every change is written to disk before it is visible.
+//
+// Watch history is not persisted, watches cannot start before the revision the
+// store was opened at.
+func NewBoltStore(path string) (Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open bolt database %s", path)
+	}
+
+	s := newMemoryStore(&boltBackend{db: db})
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{boltKVBucket, boltLeaseBucket, boltMetaBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return loadBolt(tx, s)
+	})
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrapf(err, "failed to load bolt database %s", path)
+	}
+
+	// keys of leases which were lost can never expire, drop them
+	orphans := []Op{}
+	for key, kv := range s.kvs {
+		if _, ok := s.leases[LeaseID(kv.Lease)]; kv.Lease != int64(NoLease) && !ok {
+			orphans = append(orphans, OpDelete(key))
+		}
+	}
+	if _, err := s.apply(orphans); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "failed to drop keys of lost leases")
+	}
+
+	go s.expireLeases()
+	return s, nil
+}
+
+func loadBolt(tx *bolt.Tx, s *memoryStore) error {
+	meta := tx.Bucket(boltMetaBucket)
+	if rev := meta.Get(boltRevKey); rev != nil {
+		s.rev = int64(binary.BigEndian.Uint64(rev))
+		s.compactRev = s.rev
+	}
+	if lease := meta.Get(boltLeaseKey); lease != nil {
+		s.lastLease = LeaseID(binary.BigEndian.Uint64(lease))
+	}
+
+	err := tx.Bucket(boltLeaseBucket).ForEach(func(k, v []byte) error {
+		lease := &boltLease{}
+		if err := json.Unmarshal(v, lease); err != nil {
+			return errors.Wrap(err, "failed to unmarshal lease")
+		}
+		s.leases[LeaseID(binary.BigEndian.Uint64(k))] = &memoryLease{
+			ttl:    lease.TTL,
+			expiry: lease.Expiry,
+			keys:   map[string]struct{}{},
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(boltKVBucket).ForEach(func(k, v []byte) error {
+		kv := &KeyValue{}
+		if err := json.Unmarshal(v, kv); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal key %s", k)
+		}
+		s.kvs[string(k)] = kv
+		if lease, ok := s.leases[LeaseID(kv.Lease)]; ok {
+			lease.keys[string(k)] = struct{}{}
+		}
+		return nil
+	})
+}
+
+func (b *boltBackend) saveEvents(rev int64, events []*Event) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		kvs := tx.Bucket(boltKVBucket)
+		for _, ev := range events {
+			if ev.Type == EventTypeDelete {
+				if err := kvs.Delete(ev.Kv.Key); err != nil {
+					return err
+				}
+				continue
+			}
+
+			val, err := json.Marshal(ev.Kv)
+			if err != nil {
+				return err
+			}
+			if err := kvs.Put(ev.Kv.Key, val); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(boltMetaBucket).Put(boltRevKey, itob(uint64(rev)))
+	})
+}
+
+func (b *boltBackend) saveLease(id LeaseID, lease *memoryLease) error {
+	val, err := json.Marshal(&boltLease{TTL: lease.ttl, Expiry: lease.expiry})
+	if err != nil {
+		return err
+	}
+
+	return b.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(boltLeaseBucket).Put(itob(uint64(id)), val); err != nil {
+			return err
+		}
+
+		meta := tx.Bucket(boltMetaBucket)
+		if last := meta.Get(boltLeaseKey); last != nil && LeaseID(binary.BigEndian.Uint64(last)) >= id {
+			return nil
+		}
+		return meta.Put(boltLeaseKey, itob(uint64(id)))
+	})
+}
+
+func (b *boltBackend) deleteLease(id LeaseID) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltLeaseBucket).Delete(itob(uint64(id)))
+	})
+}
+
+func (b *boltBackend) close() error {
+	return b.db.Close()
+}
+
+func itob(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"golang.org/x/net/context"
+)
+
+type etcdStore struct {
+	client *clientv3.Client
+}
+
+// NewEtcdStore returns a Store backed by an etcd v3 cluster.
+// Closing the store closes the client.
+func NewEtcdStore(client *clientv3.Client) Store {
+	return &etcdStore{client: client}
+}
+
+func (s *etcdStore) Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error) {
+	resp, err := s.client.KV.Get(ctx, key, OpGet(key, opts...).etcdOpts()...)
+	if err != nil {
+		return nil, err
+	}
+	return etcdGetResponse(resp), nil
+}
+
+func (s *etcdStore) Put(ctx context.Context, key, val string, opts ...OpOption) (*PutResponse, error) {
+	resp, err := s.client.KV.Put(ctx, key, val, OpPut(key, val, opts...).etcdOpts()...)
+	if err != nil {
+		return nil, err
+	}
+	return &PutResponse{Revision: resp.Header.Revision}, nil
+}
+
+func (s *etcdStore) Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error) {
+	resp, err := s.client.KV.Delete(ctx, key, OpDelete(key, opts...).etcdOpts()...)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteResponse{
+		Deleted:  resp.Deleted,
+		Revision: resp.Header.Revision,
+	}, nil
+}
+
+func (s *etcdStore) Txn(ctx context.Context) Txn {
+	return &etcdTxn{txn: s.client.KV.Txn(ctx)}
+}
+
+func (s *etcdStore) Grant(ctx context.Context, ttl int64) (LeaseID, error) {
+	resp, err := s.client.Lease.Grant(ctx, ttl)
+	if err != nil {
+		return NoLease, err
+	}
+	return LeaseID(resp.ID), nil
+}
+
+func (s *etcdStore) KeepAliveOnce(ctx context.Context, id LeaseID) error {
+	_, err := s.client.Lease.KeepAliveOnce(ctx, clientv3.LeaseID(id))
+	return err
+}
+
+func (s *etcdStore) Revoke(ctx context.Context, id LeaseID) error {
+	_, err := s.client.Lease.Revoke(ctx, clientv3.LeaseID(id))
+	return err
+}
+
+func (s *etcdStore) Watch(ctx context.Context, key string, opts ...OpOption) WatchChan {
+	ch := make(chan WatchResponse)
+	wch := s.client.Watch(ctx, key, OpGet(key, opts...).etcdOpts()...)
+	go func() {
+		defer close(ch)
+		for resp := range wch {
+			wr := WatchResponse{Revision: resp.Header.Revision}
+			if resp.CompactRevision != 0 {
+				wr.err = ErrCompacted
+			} else {
+				wr.err = resp.Err()
+			}
+
+			for _, ev := range resp.Events {
+				event := &Event{Type: EventTypePut, Kv: etcdKeyValue(ev.Kv)}
+				if ev.Type == clientv3.EventTypeDelete {
+					event.Type = EventTypeDelete
+				}
+				wr.Events = append(wr.Events, event)
+			}
+
+			select {
+			case ch <- wr:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func (s *etcdStore) Close() error {
+	return s.client.Close()
+}
+
+type etcdTxn struct {
+	txn clientv3.Txn
+}
+
+func (t *etcdTxn) If(cmps ...Cmp) Txn {
+	etcdCmps := make([]clientv3.Cmp, 0, len(cmps))
+	for _, cmp := range cmps {
+		etcdCmps = append(etcdCmps, cmp.etcdCmp())
+	}
+	t.txn = t.txn.If(etcdCmps...)
+	return t
+}
+
+func (t *etcdTxn) Then(ops ...Op) Txn {
+	etcdOps := make([]clientv3.Op, 0, len(ops))
+	for _, op := range ops {
+		etcdOps = append(etcdOps, op.etcdOp())
+	}
+	t.txn = t.txn.Then(etcdOps...)
+	return t
+}
+
+func (t *etcdTxn) Commit() (*TxnResponse, error) {
+	resp, err := t.txn.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &TxnResponse{
+		Succeeded: resp.Succeeded,
+		Revision:  resp.Header.Revision,
+	}, nil
+}
+
+func (op Op) etcdOp() clientv3.Op {
+	switch op.t {
+	case tPut:
+		return clientv3.OpPut(op.key, op.val, op.etcdOpts()...)
+	case tDelete:
+		return clientv3.OpDelete(op.key, op.etcdOpts()...)
+	default:
+		return clientv3.OpGet(op.key, op.etcdOpts()...)
+	}
+}
+
+func (op Op) etcdOpts() []clientv3.OpOption {
+	opts := []clientv3.OpOption{}
+	if op.end != "" {
+		opts = append(opts, clientv3.WithRange(op.end))
+	}
+	if op.lease != NoLease {
+		opts = append(opts, clientv3.WithLease(clientv3.LeaseID(op.lease)))
+	}
+	if op.rev > 0 {
+		opts = append(opts, clientv3.WithRev(op.rev))
+	}
+	if op.limit > 0 {
+		opts = append(opts, clientv3.WithLimit(op.limit))
+	}
+	if op.countOnly {
+		opts = append(opts, clientv3.WithCountOnly())
+	}
+	return opts
+}
+
+func (cmp Cmp) etcdCmp() clientv3.Cmp {
+	switch cmp.target {
+	case targetValue:
+		return clientv3.Compare(clientv3.Value(cmp.key), cmp.result, cmp.value)
+	case targetModRevision:
+		return clientv3.Compare(clientv3.ModRevision(cmp.key), cmp.result, cmp.version)
+	}
+	return clientv3.Compare(clientv3.Version(cmp.key), cmp.result, cmp.version)
+}
+
+func etcdGetResponse(resp *clientv3.GetResponse) *GetResponse {
+	kvs := make([]*KeyValue, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		kvs = append(kvs, etcdKeyValue(kv))
+	}
+	return &GetResponse{
+		Kvs:      kvs,
+		More:     resp.More,
+		Count:    resp.Count,
+		Revision: resp.Header.Revision,
+	}
+}
+
+func etcdKeyValue(kv *mvccpb.KeyValue) *KeyValue {
+	return &KeyValue{
+		Key:            kv.Key,
+		Value:          kv.Value,
+		CreateRevision: kv.CreateRevision,
+		ModRevision:    kv.ModRevision,
+		Version:        kv.Version,
+		Lease:          kv.Lease,
+	}
+}
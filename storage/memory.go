@@ -0,0 +1,562 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// memoryHistorySize is the number of events the memory store keeps for
+	// watches which start at a past revision.
+	memoryHistorySize = 10000
+	// memoryLeaseInterval is how often the memory store checks for expired leases.
+	memoryLeaseInterval = 100 * time.Millisecond
+)
+
+var (
+	// ErrLeaseNotFound is returned when a lease has expired or was never granted.
+	ErrLeaseNotFound = errors.New("storage: lease not found")
+	// ErrClosed is returned by stores which have been closed.
+	ErrClosed = errors.New("storage: store closed")
+
+	errPastRevision = errors.New("storage: reads at past revisions are not supported")
+)
+
+type memoryLease struct {
+	ttl    time.Duration
+	expiry time.Time
+	keys   map[string]struct{}
+}
+
+type memoryStore struct {
+	mu sync.Mutex
+
+	rev       int64
+	kvs       map[string]*KeyValue
+	leases    map[LeaseID]*memoryLease
+	lastLease LeaseID
+
+	// history holds the most recent events in revision order. Watches can
+	// start at any revision after compactRev.
+	history    []*Event
+	compactRev int64
+	watchers   map[*memoryWatcher]struct{}
+
+	// backend persists every change before it becomes visible, it is nil
+	// for stores which only live in memory.
+	backend backend
+
+	done chan struct{}
+}
+
+// backend persists the state of a memory store. All calls are made while
+// the store's lock is held.
+type backend interface {
+	// saveEvents records the events of a single revision.
+	saveEvents(rev int64, events []*Event) error
+	saveLease(id LeaseID, lease *memoryLease) error
+	deleteLease(id LeaseID) error
+	close() error
+}
+
+// NewMemoryStore returns a Store which keeps all of its state in process.
+// It is meant for tests and throwaway servers, all state is lost on Close.
+func NewMemoryStore() Store {
+	s := newMemoryStore(nil)
+	go s.expireLeases()
+	return s
+}
+
+func newMemoryStore(backend backend) *memoryStore {
+	return &memoryStore{
+		kvs:      map[string]*KeyValue{},
+		leases:   map[LeaseID]*memoryLease{},
+		watchers: map[*memoryWatcher]struct{}{},
+		backend:  backend,
+		done:     make(chan struct{}),
+	}
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error) {
+	op := OpGet(key, opts...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		return nil, ErrClosed
+	}
+
+	if op.rev > 0 && op.rev < s.rev {
+		return nil, errPastRevision
+	}
+
+	kvs := s.rangeKeys(op)
+	resp := &GetResponse{
+		Count:    int64(len(kvs)),
+		Revision: s.rev,
+	}
+	if op.countOnly {
+		return resp, nil
+	}
+	if op.limit > 0 && int64(len(kvs)) > op.limit {
+		kvs = kvs[:op.limit]
+		resp.More = true
+	}
+	for _, kv := range kvs {
+		resp.Kvs = append(resp.Kvs, copyKeyValue(kv))
+	}
+	return resp, nil
+}
+
+func (s *memoryStore) Put(ctx context.Context, key, val string, opts ...OpOption) (*PutResponse, error) {
+	resp, err := s.Txn(ctx).Then(OpPut(key, val, opts...)).Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &PutResponse{Revision: resp.Revision}, nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error) {
+	op := OpDelete(key, opts...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		return nil, ErrClosed
+	}
+
+	events, err := s.apply([]Op{op})
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteResponse{
+		Deleted:  int64(len(events)),
+		Revision: s.rev,
+	}, nil
+}
+
+func (s *memoryStore) Txn(ctx context.Context) Txn {
+	return &memoryTxn{store: s}
+}
+
+func (s *memoryStore) Grant(ctx context.Context, ttl int64) (LeaseID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		return NoLease, ErrClosed
+	}
+
+	id := s.lastLease + 1
+	lease := &memoryLease{
+		ttl:  time.Duration(ttl) * time.Second,
+		keys: map[string]struct{}{},
+	}
+	lease.expiry = time.Now().Add(lease.ttl)
+	if err := s.saveLease(id, lease); err != nil {
+		return NoLease, err
+	}
+
+	s.lastLease = id
+	s.leases[id] = lease
+	return id, nil
+}
+
+func (s *memoryStore) KeepAliveOnce(ctx context.Context, id LeaseID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		return ErrClosed
+	}
+
+	lease, ok := s.leases[id]
+	if !ok {
+		return ErrLeaseNotFound
+	}
+
+	renewed := *lease
+	renewed.expiry = time.Now().Add(lease.ttl)
+	if err := s.saveLease(id, &renewed); err != nil {
+		return err
+	}
+	lease.expiry = renewed.expiry
+	return nil
+}
+
+func (s *memoryStore) Revoke(ctx context.Context, id LeaseID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		return ErrClosed
+	}
+
+	lease, ok := s.leases[id]
+	if !ok {
+		return ErrLeaseNotFound
+	}
+	return s.revoke(id, lease)
+}
+
+func (s *memoryStore) Watch(ctx context.Context, key string, opts ...OpOption) WatchChan {
+	w := &memoryWatcher{
+		op:     OpGet(key, opts...),
+		ch:     make(chan WatchResponse),
+		notify: make(chan struct{}, 1),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		close(w.ch)
+		return w.ch
+	}
+
+	if w.op.rev > 0 && w.op.rev <= s.compactRev {
+		w.queue = append(w.queue, WatchResponse{Revision: s.rev, err: ErrCompacted})
+		w.notify <- struct{}{}
+	} else if w.op.rev > 0 {
+		w.send(s.history, w.op.rev)
+	}
+	s.watchers[w] = struct{}{}
+
+	go s.runWatcher(ctx, w)
+	return w.ch
+}
+
+func (s *memoryStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		return nil
+	}
+	close(s.done)
+	if s.backend != nil {
+		return s.backend.close()
+	}
+	return nil
+}
+
+func (s *memoryStore) isClosed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// rangeKeys returns the live keys the op applies to in key order.
+// The caller must hold s.mu.
+func (s *memoryStore) rangeKeys(op Op) []*KeyValue {
+	if !op.isRange() {
+		if kv, ok := s.kvs[op.key]; ok {
+			return []*KeyValue{kv}
+		}
+		return nil
+	}
+
+	kvs := []*KeyValue{}
+	for key, kv := range s.kvs {
+		if op.inRange(key) {
+			kvs = append(kvs, kv)
+		}
+	}
+	sort.Sort(kvsByKey(kvs))
+	return kvs
+}
+
+// commit applies the ops if all cmps hold.
+func (s *memoryStore) commit(cmps []Cmp, ops []Op) (*TxnResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed() {
+		return nil, ErrClosed
+	}
+
+	for _, cmp := range cmps {
+		if !cmp.holds(s.kvs[cmp.key]) {
+			return &TxnResponse{Succeeded: false, Revision: s.rev}, nil
+		}
+	}
+
+	for _, op := range ops {
+		if op.t == tPut && op.lease != NoLease {
+			if _, ok := s.leases[op.lease]; !ok {
+				return nil, ErrLeaseNotFound
+			}
+		}
+	}
+
+	if _, err := s.apply(ops); err != nil {
+		return nil, err
+	}
+	return &TxnResponse{Succeeded: true, Revision: s.rev}, nil
+}
+
+// apply performs the ops as a single revision and notifies watchers.
+// The caller must hold s.mu and have checked that the leases of the ops exist.
+func (s *memoryStore) apply(ops []Op) ([]*Event, error) {
+	rev := s.rev + 1
+	events := []*Event{}
+
+	for _, op := range ops {
+		switch op.t {
+		case tPut:
+			kv, ok := s.kvs[op.key]
+			if !ok {
+				kv = &KeyValue{Key: []byte(op.key), CreateRevision: rev}
+				s.kvs[op.key] = kv
+			}
+			s.detachLease(kv)
+			kv.Value = []byte(op.val)
+			kv.ModRevision = rev
+			kv.Version++
+			kv.Lease = int64(op.lease)
+			if lease, ok := s.leases[op.lease]; ok {
+				lease.keys[op.key] = struct{}{}
+			}
+			events = append(events, &Event{Type: EventTypePut, Kv: copyKeyValue(kv)})
+		case tDelete:
+			for _, kv := range s.rangeKeys(op) {
+				s.detachLease(kv)
+				delete(s.kvs, string(kv.Key))
+				events = append(events, &Event{
+					Type: EventTypeDelete,
+					Kv:   &KeyValue{Key: kv.Key, ModRevision: rev},
+				})
+			}
+		}
+	}
+
+	// like etcd, a transaction which changes nothing does not bump the revision
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	if s.backend != nil {
+		if err := s.backend.saveEvents(rev, events); err != nil {
+			return nil, s.fail(err)
+		}
+	}
+
+	s.rev = rev
+	s.history = append(s.history, events...)
+	if len(s.history) > memoryHistorySize {
+		trim := len(s.history) - memoryHistorySize
+		s.compactRev = s.history[trim-1].Kv.ModRevision
+		s.history = append([]*Event{}, s.history[trim:]...)
+	}
+
+	for w := range s.watchers {
+		w.send(events, 0)
+	}
+	return events, nil
+}
+
+func (s *memoryStore) saveLease(id LeaseID, lease *memoryLease) error {
+	if s.backend == nil {
+		return nil
+	}
+	if err := s.backend.saveLease(id, lease); err != nil {
+		return s.fail(err)
+	}
+	return nil
+}
+
+// fail closes a store whose backend could not persist a change. The change may
+// already be applied in memory, so the store must not serve it.
+// The caller must hold s.mu.
+func (s *memoryStore) fail(err error) error {
+	plog.Errorf("closing store, failed to persist change: %v", err)
+	close(s.done)
+	if cerr := s.backend.close(); cerr != nil {
+		plog.Errorf("failed to close storage backend: %v", cerr)
+	}
+	return ErrClosed
+}
+
+func (s *memoryStore) detachLease(kv *KeyValue) {
+	if lease, ok := s.leases[LeaseID(kv.Lease)]; ok {
+		delete(lease.keys, string(kv.Key))
+	}
+}
+
+// revoke deletes the lease and its keys.
+// The caller must hold s.mu.
+func (s *memoryStore) revoke(id LeaseID, lease *memoryLease) error {
+	ops := []Op{}
+	for key := range lease.keys {
+		ops = append(ops, OpDelete(key))
+	}
+	if _, err := s.apply(ops); err != nil {
+		return err
+	}
+	if s.backend != nil {
+		if err := s.backend.deleteLease(id); err != nil {
+			return s.fail(err)
+		}
+	}
+	delete(s.leases, id)
+	return nil
+}
+
+// expireLeases revokes expired leases, deleting their keys, until the store is closed.
+func (s *memoryStore) expireLeases() {
+	ticker := time.NewTicker(memoryLeaseInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case now := <-ticker.C:
+			s.mu.Lock()
+			for id, lease := range s.leases {
+				if now.Before(lease.expiry) {
+					continue
+				}
+				if err := s.revoke(id, lease); err != nil {
+					break
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *memoryStore) runWatcher(ctx context.Context, w *memoryWatcher) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.watchers, w)
+		s.mu.Unlock()
+		close(w.ch)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		case <-w.notify:
+		}
+
+		for _, resp := range w.drain() {
+			select {
+			case w.ch <- resp:
+			case <-ctx.Done():
+				return
+			case <-s.done:
+				return
+			}
+
+			if resp.err != nil {
+				return
+			}
+		}
+	}
+}
+
+type memoryTxn struct {
+	store *memoryStore
+	cmps  []Cmp
+	ops   []Op
+}
+
+func (t *memoryTxn) If(cmps ...Cmp) Txn {
+	t.cmps = append(t.cmps, cmps...)
+	return t
+}
+
+func (t *memoryTxn) Then(ops ...Op) Txn {
+	t.ops = append(t.ops, ops...)
+	return t
+}
+
+func (t *memoryTxn) Commit() (*TxnResponse, error) {
+	return t.store.commit(t.cmps, t.ops)
+}
+
+// memoryWatcher queues events for a single watch so the store never blocks on
+// a slow reader.
+type memoryWatcher struct {
+	op     Op
+	ch     chan WatchResponse
+	notify chan struct{}
+
+	mu    sync.Mutex
+	queue []WatchResponse
+}
+
+// send queues the events the watcher is interested in, grouped by revision.
+// Events before fromRev are skipped.
+func (w *memoryWatcher) send(events []*Event, fromRev int64) {
+	w.mu.Lock()
+	for _, ev := range events {
+		if ev.Kv.ModRevision < fromRev || !w.op.inRange(string(ev.Kv.Key)) {
+			continue
+		}
+
+		rev := ev.Kv.ModRevision
+		if n := len(w.queue); n == 0 || w.queue[n-1].Revision != rev || w.queue[n-1].err != nil {
+			w.queue = append(w.queue, WatchResponse{Revision: rev})
+		}
+		resp := &w.queue[len(w.queue)-1]
+		resp.Events = append(resp.Events, ev)
+	}
+	pending := len(w.queue) > 0
+	w.mu.Unlock()
+
+	if pending {
+		select {
+		case w.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (w *memoryWatcher) drain() []WatchResponse {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	queue := w.queue
+	w.queue = nil
+	return queue
+}
+
+type kvsByKey []*KeyValue
+
+func (k kvsByKey) Len() int           { return len(k) }
+func (k kvsByKey) Swap(i, j int)      { k[i], k[j] = k[j], k[i] }
+func (k kvsByKey) Less(i, j int) bool { return string(k[i].Key) < string(k[j].Key) }
+
+func copyKeyValue(kv *KeyValue) *KeyValue {
+	c := *kv
+	return &c
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import "fmt"
+
+type opType int
+
+const (
+	tGet opType = iota
+	tPut
+	tDelete
+)
+
+// Op is a single operation of a transaction.
+type Op struct {
+	t     opType
+	key   string
+	end   string
+	val   string
+	lease LeaseID
+	rev   int64
+	limit int64
+
+	countOnly bool
+}
+
+// OpOption configures an Op.
+type OpOption func(*Op)
+
+// OpGet returns a get operation.
+func OpGet(key string, opts ...OpOption) Op {
+	op := Op{t: tGet, key: key}
+	op.applyOpts(opts)
+	return op
+}
+
+// OpPut returns a put operation.
+func OpPut(key, val string, opts ...OpOption) Op {
+	op := Op{t: tPut, key: key, val: val}
+	op.applyOpts(opts)
+	return op
+}
+
+// OpDelete returns a delete operation.
+func OpDelete(key string, opts ...OpOption) Op {
+	op := Op{t: tDelete, key: key}
+	op.applyOpts(opts)
+	return op
+}
+
+func (op *Op) applyOpts(opts []OpOption) {
+	for _, opt := range opts {
+		opt(op)
+	}
+}
+
+// isRange reports whether the op applies to a range of keys rather than a single key.
+func (op Op) isRange() bool {
+	return len(op.end) > 0
+}
+
+// inRange reports whether key falls within the keys the op applies to.
+func (op Op) inRange(key string) bool {
+	if !op.isRange() {
+		return key == op.key
+	}
+	if key < op.key {
+		return false
+	}
+	return op.end == "\x00" || key < op.end
+}
+
+// WithPrefix makes the op apply to every key starting with the op's key.
+func WithPrefix() OpOption {
+	return func(op *Op) {
+		op.end = PrefixEnd(op.key)
+	}
+}
+
+// WithRange makes the op apply to every key from the op's key up to, but
+// excluding, end.
+func WithRange(end string) OpOption {
+	return func(op *Op) {
+		op.end = end
+	}
+}
+
+// WithFromKey makes the op apply to every key greater than or equal to the op's key.
+func WithFromKey() OpOption {
+	return func(op *Op) {
+		op.end = "\x00"
+	}
+}
+
+// WithLease attaches the key of a put to the lease.
+func WithLease(id LeaseID) OpOption {
+	return func(op *Op) {
+		op.lease = id
+	}
+}
+
+// WithRev sets the revision a get reads at or a watch starts from.
+func WithRev(rev int64) OpOption {
+	return func(op *Op) {
+		op.rev = rev
+	}
+}
+
+// WithLimit limits the number of keys a get returns. A limit of 0 means no limit.
+func WithLimit(limit int64) OpOption {
+	return func(op *Op) {
+		op.limit = limit
+	}
+}
+
+// WithCountOnly makes a get return only the number of keys in its range.
+func WithCountOnly() OpOption {
+	return func(op *Op) {
+		op.countOnly = true
+	}
+}
+
+// PrefixEnd returns the first key after all keys starting with prefix.
+func PrefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	// every key starts with the prefix
+	return "\x00"
+}
+
+type compareTarget int
+
+const (
+	targetVersion compareTarget = iota
+	targetValue
+	targetModRevision
+)
+
+// Cmp is a comparison on a key, used as a condition of a transaction.
+type Cmp struct {
+	key     string
+	target  compareTarget
+	result  string
+	version int64
+	value   string
+}
+
+// Version starts a comparison on the version of the key.
+// The version of a key that does not exist is 0.
+func Version(key string) Cmp {
+	return Cmp{key: key, target: targetVersion}
+}
+
+// ModRevision starts a comparison on the revision of the last modification of the key.
+// The revision of a key that does not exist is 0.
+func ModRevision(key string) Cmp {
+	return Cmp{key: key, target: targetModRevision}
+}
+
+// Value starts a comparison on the value of the key.
+func Value(key string) Cmp {
+	return Cmp{key: key, target: targetValue}
+}
+
+// Compare completes the comparison with one of "=", "!=", "<" or ">" and the
+// value to compare against, an integer for versions and revisions or a string
+// for values.
+func Compare(cmp Cmp, result string, v interface{}) Cmp {
+	switch result {
+	case "=", "!=", "<", ">":
+	default:
+		panic(fmt.Sprintf("storage: unknown comparison %q", result))
+	}
+	cmp.result = result
+
+	switch cmp.target {
+	case targetVersion, targetModRevision:
+		switch val := v.(type) {
+		case int:
+			cmp.version = int64(val)
+		case int64:
+			cmp.version = val
+		default:
+			panic(fmt.Sprintf("storage: version comparison needs an integer, got %T", v))
+		}
+	case targetValue:
+		val, ok := v.(string)
+		if !ok {
+			panic(fmt.Sprintf("storage: value comparison needs a string, got %T", v))
+		}
+		cmp.value = val
+	}
+	return cmp
+}
+
+// holds evaluates the comparison against kv, which is nil if the key does not exist.
+func (cmp Cmp) holds(kv *KeyValue) bool {
+	var c int
+	switch cmp.target {
+	case targetVersion, targetModRevision:
+		var version int64
+		switch {
+		case kv == nil:
+		case cmp.target == targetVersion:
+			version = kv.Version
+		default:
+			version = kv.ModRevision
+		}
+		switch {
+		case version < cmp.version:
+			c = -1
+		case version > cmp.version:
+			c = 1
+		}
+	case targetValue:
+		// a missing key never matches a value comparison
+		if kv == nil {
+			return false
+		}
+		switch {
+		case string(kv.Value) < cmp.value:
+			c = -1
+		case string(kv.Value) > cmp.value:
+			c = 1
+		}
+	}
+
+	switch cmp.result {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case ">":
+		return c > 0
+	}
+	return false
+}
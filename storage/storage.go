@@ -0,0 +1,157 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storage defines the key value store postal keeps its registry and
+// ipam state in, along with the backends implementing it.
+//
+// The store follows the etcd v3 data model. Every key carries a version which
+// is reset when the key is deleted, every change bumps the store wide revision,
+// and keys may be attached to leases which delete them when they expire.
+// Transactions compare key versions and values to implement compare-and-swap
+// updates.
+package storage
+
+import (
+	"errors"
+
+	"github.com/coreos/pkg/capnslog"
+	"golang.org/x/net/context"
+)
+
+var (
+	plog = capnslog.NewPackageLogger("github.com/jive/postal", "storage")
+)
+
+// ErrCompacted is returned by watches which were asked to start at a revision
+// the store no longer keeps.
+var ErrCompacted = errors.New("storage: required revision has been compacted")
+
+// Store is the interface postal uses to read and write its state.
+type Store interface {
+	// Get retrieves the key, or with WithPrefix or WithFromKey a range of keys
+	// in ascending key order.
+	Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error)
+	// Put sets the value of the key, attaching it to a lease with WithLease.
+	Put(ctx context.Context, key, val string, opts ...OpOption) (*PutResponse, error)
+	// Delete removes the key, or with WithPrefix or WithFromKey a range of keys.
+	Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error)
+	// Txn creates a transaction which applies its ops only if all of its
+	// comparisons hold.
+	Txn(ctx context.Context) Txn
+	// Grant creates a lease which expires after ttl seconds.
+	Grant(ctx context.Context, ttl int64) (LeaseID, error)
+	// KeepAliveOnce renews the lease for another ttl period.
+	KeepAliveOnce(ctx context.Context, id LeaseID) error
+	// Revoke ends the lease right away, deleting the keys attached to it.
+	Revoke(ctx context.Context, id LeaseID) error
+	// Watch streams changes of the key, or with WithPrefix a range of keys,
+	// starting at the revision given with WithRev. The channel is closed when
+	// ctx is done.
+	Watch(ctx context.Context, key string, opts ...OpOption) WatchChan
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// Txn is a compare-and-swap transaction.
+type Txn interface {
+	// If adds comparisons which must all hold for the transaction to succeed.
+	If(cmps ...Cmp) Txn
+	// Then adds the ops applied when the transaction succeeds.
+	Then(ops ...Op) Txn
+	// Commit applies the transaction.
+	Commit() (*TxnResponse, error)
+}
+
+// LeaseID identifies a lease granted by a Store.
+type LeaseID int64
+
+// NoLease is the LeaseID of keys which are not attached to a lease.
+const NoLease LeaseID = 0
+
+// KeyValue is a key with its value and metadata.
+type KeyValue struct {
+	Key   []byte
+	Value []byte
+	// CreateRevision is the revision of the last creation of the key.
+	CreateRevision int64
+	// ModRevision is the revision of the last modification of the key.
+	ModRevision int64
+	// Version is the number of modifications since the key was created.
+	Version int64
+	// Lease is the ID of the lease attached to the key, or NoLease.
+	Lease int64
+}
+
+// GetResponse is the result of a Get.
+type GetResponse struct {
+	Kvs []*KeyValue
+	// More indicates that more keys are in the range than were returned.
+	More bool
+	// Count is the number of keys in the range.
+	Count int64
+	// Revision is the store revision the range was read at.
+	Revision int64
+}
+
+// PutResponse is the result of a Put.
+type PutResponse struct {
+	Revision int64
+}
+
+// DeleteResponse is the result of a Delete.
+type DeleteResponse struct {
+	Deleted  int64
+	Revision int64
+}
+
+// TxnResponse is the result of a committed Txn.
+type TxnResponse struct {
+	Succeeded bool
+	Revision  int64
+}
+
+// EventType is the kind of change a watch Event describes.
+type EventType int
+
+const (
+	// EventTypePut is a key being created or modified.
+	EventTypePut EventType = iota
+	// EventTypeDelete is a key being deleted or expiring with its lease.
+	EventTypeDelete
+)
+
+// Event is a single change of a watched key.
+type Event struct {
+	Type EventType
+	// Kv is the key after the change. For deletes only Key and ModRevision are set.
+	Kv *KeyValue
+}
+
+// WatchResponse holds the events of a single revision.
+type WatchResponse struct {
+	Events   []*Event
+	Revision int64
+
+	err error
+}
+
+// Err returns the error which ended the watch, if any.
+func (wr WatchResponse) Err() error {
+	return wr.err
+}
+
+// WatchChan streams the changes of a watch.
+type WatchChan <-chan WatchResponse
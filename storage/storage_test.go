@@ -0,0 +1,346 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/stretchr/testify/assert"
+)
+
+// storeTest runs the same checks against every backend, so they all keep the
+// semantics postal relies on.
+type storeTest func(assert *assert.Assertions, store Store)
+
+var storeTests = map[string]storeTest{
+	"KV":    testStoreKV,
+	"Txn":   testStoreTxn,
+	"Lease": testStoreLease,
+	"Watch": testStoreWatch,
+}
+
+func TestEtcdStore(t *testing.T) {
+	// storagetest.EnvStorage, which cannot be imported from here
+	if os.Getenv("POSTAL_TEST_STORAGE") != "etcd" {
+		t.Skip("POSTAL_TEST_STORAGE is not etcd")
+	}
+
+	for name, test := range storeTests {
+		assert := assert.New(t)
+		cli, err := clientv3.New(clientv3.Config{
+			Endpoints:   []string{"127.0.0.1:2379"},
+			DialTimeout: 5 * time.Second,
+		})
+		assert.NoError(err, name)
+
+		store := NewEtcdStore(cli)
+		test(assert, store)
+
+		store.Delete(context.Background(), "/", WithPrefix())
+		store.Close()
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	for _, test := range storeTests {
+		store := NewMemoryStore()
+		test(assert.New(t), store)
+		store.Close()
+	}
+}
+
+func TestBoltStore(t *testing.T) {
+	for name, test := range storeTests {
+		dir, err := ioutil.TempDir("", "postal-bolt")
+		assert.NoError(t, err, name)
+
+		store, err := NewBoltStore(filepath.Join(dir, "postal.db"))
+		assert.NoError(t, err, name)
+		test(assert.New(t), store)
+
+		store.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestBoltStoreReopen(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "postal-bolt")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "postal.db")
+
+	store, err := NewBoltStore(path)
+	assert.NoError(err)
+
+	_, err = store.Put(ctx, "/reopen/a", "1")
+	assert.NoError(err)
+	put, err := store.Put(ctx, "/reopen/a", "2")
+	assert.NoError(err)
+	_, err = store.Put(ctx, "/reopen/b", "3")
+	assert.NoError(err)
+	_, err = store.Delete(ctx, "/reopen/b")
+	assert.NoError(err)
+
+	lease, err := store.Grant(ctx, 2)
+	assert.NoError(err)
+	_, err = store.Put(ctx, "/reopen/leased", "4", WithLease(lease))
+	assert.NoError(err)
+	assert.NoError(store.Close())
+
+	store, err = NewBoltStore(path)
+	assert.NoError(err)
+	defer store.Close()
+
+	resp, err := store.Get(ctx, "/reopen/", WithPrefix())
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 2)
+	assert.Equal("2", string(resp.Kvs[0].Value))
+	assert.Equal(int64(2), resp.Kvs[0].Version)
+	assert.Equal(put.Revision, resp.Kvs[0].ModRevision)
+	assert.Equal(int64(lease), resp.Kvs[1].Lease)
+
+	// versions still guard compare-and-swap after a restart
+	txn, err := store.Txn(ctx).If(
+		Compare(Version("/reopen/a"), "=", 2),
+	).Then(
+		OpPut("/reopen/a", "5"),
+	).Commit()
+	assert.NoError(err)
+	assert.True(txn.Succeeded)
+	assert.True(txn.Revision > resp.Revision)
+
+	next, err := store.Grant(ctx, 2)
+	assert.NoError(err)
+	assert.True(next > lease)
+
+	time.Sleep(3 * time.Second)
+
+	resp, err = store.Get(ctx, "/reopen/leased")
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 0)
+}
+
+func TestMemoryStoreCompactedWatch(t *testing.T) {
+	assert := assert.New(t)
+	store := NewMemoryStore()
+	defer store.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := store.Put(ctx, "/compact/key", "0")
+	assert.NoError(err)
+	for i := 0; i < memoryHistorySize; i++ {
+		_, err = store.Put(ctx, "/compact/key", "1")
+		assert.NoError(err)
+	}
+
+	resp, ok := <-store.Watch(ctx, "/compact/", WithPrefix(), WithRev(first.Revision))
+	assert.True(ok)
+	assert.Equal(ErrCompacted, resp.Err())
+}
+
+func testStoreKV(assert *assert.Assertions, store Store) {
+	ctx := context.Background()
+
+	_, err := store.Put(ctx, "/test/a", "1")
+	assert.NoError(err)
+	_, err = store.Put(ctx, "/test/a", "2")
+	assert.NoError(err)
+	_, err = store.Put(ctx, "/test/b", "3")
+	assert.NoError(err)
+	_, err = store.Put(ctx, "/testing", "4")
+	assert.NoError(err)
+
+	resp, err := store.Get(ctx, "/test/a")
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 1)
+	assert.Equal("2", string(resp.Kvs[0].Value))
+	assert.Equal(int64(2), resp.Kvs[0].Version)
+
+	resp, err = store.Get(ctx, "/test/", WithPrefix())
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 2)
+	assert.Equal("/test/a", string(resp.Kvs[0].Key))
+	assert.Equal("/test/b", string(resp.Kvs[1].Key))
+
+	resp, err = store.Get(ctx, "/test/", WithPrefix(), WithLimit(1))
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 1)
+	assert.True(resp.More)
+
+	resp, err = store.Get(ctx, "/test/a\x00", WithRange(PrefixEnd("/test/")))
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 1)
+	assert.Equal("/test/b", string(resp.Kvs[0].Key))
+
+	resp, err = store.Get(ctx, "/test", WithPrefix(), WithCountOnly())
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 0)
+	assert.Equal(int64(3), resp.Count)
+
+	del, err := store.Delete(ctx, "/test/", WithPrefix())
+	assert.NoError(err)
+	assert.Equal(int64(2), del.Deleted)
+
+	resp, err = store.Get(ctx, "/test", WithPrefix())
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 1)
+
+	// a recreated key starts over at version 1
+	_, err = store.Put(ctx, "/test/a", "5")
+	assert.NoError(err)
+	resp, err = store.Get(ctx, "/test/a")
+	assert.NoError(err)
+	assert.Equal(int64(1), resp.Kvs[0].Version)
+}
+
+func testStoreTxn(assert *assert.Assertions, store Store) {
+	ctx := context.Background()
+
+	create := func() bool {
+		resp, err := store.Txn(ctx).If(
+			Compare(Version("/txn/key"), "=", 0),
+		).Then(
+			OpPut("/txn/key", "a"),
+			OpPut("/txn/other", "b"),
+		).Commit()
+		assert.NoError(err)
+		return resp.Succeeded
+	}
+	assert.True(create())
+	assert.False(create())
+
+	key, err := store.Get(ctx, "/txn/key")
+	assert.NoError(err)
+	resp, err := store.Txn(ctx).If(
+		Compare(ModRevision("/txn/key"), "<", key.Kvs[0].ModRevision),
+	).Then(
+		OpPut("/txn/key", "b"),
+	).Commit()
+	assert.NoError(err)
+	assert.False(resp.Succeeded)
+
+	resp, err = store.Txn(ctx).If(
+		Compare(Value("/txn/key"), "=", "a"),
+		Compare(Version("/txn/other"), "=", 1),
+		Compare(ModRevision("/txn/key"), "=", key.Kvs[0].ModRevision),
+	).Then(
+		OpPut("/txn/key", "c"),
+		OpDelete("/txn/other"),
+	).Commit()
+	assert.NoError(err)
+	assert.True(resp.Succeeded)
+
+	get, err := store.Get(ctx, "/txn/", WithPrefix())
+	assert.NoError(err)
+	assert.Len(get.Kvs, 1)
+	assert.Equal("c", string(get.Kvs[0].Value))
+
+	// a missing key never matches a value comparison
+	resp, err = store.Txn(ctx).If(
+		Compare(Value("/txn/other"), "!=", "b"),
+	).Then(
+		OpPut("/txn/other", "d"),
+	).Commit()
+	assert.NoError(err)
+	assert.False(resp.Succeeded)
+}
+
+func testStoreLease(assert *assert.Assertions, store Store) {
+	ctx := context.Background()
+
+	lease, err := store.Grant(ctx, 2)
+	assert.NoError(err)
+
+	_, err = store.Put(ctx, "/lease/key", "a", WithLease(lease))
+	assert.NoError(err)
+
+	resp, err := store.Get(ctx, "/lease/key")
+	assert.NoError(err)
+	assert.Equal(int64(lease), resp.Kvs[0].Lease)
+
+	time.Sleep(time.Second)
+	assert.NoError(store.KeepAliveOnce(ctx, lease))
+	time.Sleep(1500 * time.Millisecond)
+
+	resp, err = store.Get(ctx, "/lease/key")
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 1)
+
+	time.Sleep(4 * time.Second)
+
+	resp, err = store.Get(ctx, "/lease/key")
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 0)
+	assert.Error(store.KeepAliveOnce(ctx, lease))
+
+	revoked, err := store.Grant(ctx, 60)
+	assert.NoError(err)
+	_, err = store.Put(ctx, "/lease/revoked", "b", WithLease(revoked))
+	assert.NoError(err)
+	assert.NoError(store.Revoke(ctx, revoked))
+
+	resp, err = store.Get(ctx, "/lease/revoked")
+	assert.NoError(err)
+	assert.Len(resp.Kvs, 0)
+	assert.Error(store.KeepAliveOnce(ctx, revoked))
+}
+
+func testStoreWatch(assert *assert.Assertions, store Store) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	put, err := store.Put(ctx, "/watch/a", "1")
+	assert.NoError(err)
+
+	wch := store.Watch(ctx, "/watch/", WithPrefix(), WithRev(put.Revision))
+	_, err = store.Put(ctx, "/watch/b", "2")
+	assert.NoError(err)
+	_, err = store.Delete(ctx, "/watch/a")
+	assert.NoError(err)
+
+	events := []*Event{}
+	timeout := time.After(5 * time.Second)
+	for len(events) < 3 {
+		select {
+		case resp := <-wch:
+			assert.NoError(resp.Err())
+			events = append(events, resp.Events...)
+		case <-timeout:
+			assert.Fail("timed out waiting for watch events")
+			return
+		}
+	}
+
+	assert.Equal(EventTypePut, events[0].Type)
+	assert.Equal("/watch/a", string(events[0].Kv.Key))
+	assert.Equal(EventTypePut, events[1].Type)
+	assert.Equal("/watch/b", string(events[1].Kv.Key))
+	assert.Equal(EventTypeDelete, events[2].Type)
+	assert.Equal("/watch/a", string(events[2].Kv.Key))
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 Jive Communications All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storagetest provides stores for the postal test suites.
+package storagetest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/jive/postal/storage"
+	"golang.org/x/net/context"
+)
+
+// EnvStorage selects the backend the tests run against. Setting it to "etcd"
+// runs them against the etcd at 127.0.0.1:2379, "bolt" uses a temporary bolt
+// database and anything else uses memory.
+const EnvStorage = "POSTAL_TEST_STORAGE"
+
+// NewStore returns an empty store for a single test and a func which cleans it up.
+func NewStore(t *testing.T) (storage.Store, func()) {
+	switch os.Getenv(EnvStorage) {
+	case "etcd":
+		return newEtcdStore(t)
+	case "bolt":
+		return newBoltStore(t)
+	default:
+		store := storage.NewMemoryStore()
+		return store, func() { store.Close() }
+	}
+}
+
+func newEtcdStore(t *testing.T) (storage.Store, func()) {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{"127.0.0.1:2379"},
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("failed to open etcd client conn: %s", err)
+	}
+
+	store := storage.NewEtcdStore(cli)
+	return store, func() {
+		store.Delete(context.Background(), "/", storage.WithPrefix())
+		store.Close()
+	}
+}
+
+func newBoltStore(t *testing.T) (storage.Store, func()) {
+	dir, err := ioutil.TempDir("", "postal-test")
+	if err != nil {
+		t.Fatalf("failed to create bolt dir: %s", err)
+	}
+
+	store, err := storage.NewBoltStore(filepath.Join(dir, "postal.db"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("failed to open bolt store: %s", err)
+	}
+	return store, func() {
+		store.Close()
+		os.RemoveAll(dir)
+	}
+}